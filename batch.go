@@ -0,0 +1,144 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// BatchOpts include configurable options for ConvertAll.
+//
+// See the documentation for the individual fields for more information.
+type BatchOpts struct {
+	Opts
+	// Workers is the number of goroutines which perform conversions.
+	//
+	// Default:
+	//  runtime.GOMAXPROCS(0)
+	Workers int
+}
+
+// WithWorkers sets the number of goroutines used by ConvertAll
+func WithWorkers(workers int) BatchOpts {
+	return BatchOpts{
+		Workers: workers,
+	}
+}
+
+func loadBatchOpts(opts []BatchOpts) BatchOpts {
+	options := make([]Opts, len(opts))
+	result := BatchOpts{
+		Workers: runtime.GOMAXPROCS(0),
+	}
+	for i, opt := range opts {
+		options[i] = opt.Opts
+		if opt.Workers > 0 {
+			result.Workers = opt.Workers
+		}
+	}
+	result.Opts = loadOpts(options)
+	return result
+}
+
+// ConvertAll transforms each of inputs into the naming convention cv using
+// either the provided Converter or the DefaultConverter otherwise, returning
+// the results in the order of inputs.
+//
+// Identical inputs are converted once. The conversions are spread across
+// opts.Workers goroutines, so the Converter must be safe for concurrent use.
+//
+// If ctx is done before all of the inputs are converted, ConvertAll returns
+// the error of ctx. If cv is ConventionUnknown, a copy of inputs is returned.
+//
+//	caps.ConvertAll(ctx, []string{"userID", "user_name"}, caps.ConventionKebab) // [user-id user-name]
+func ConvertAll(ctx context.Context, inputs []string, cv Convention, options ...BatchOpts) ([]string, error) {
+	opts := loadBatchOpts(options)
+	results := make([]string, len(inputs))
+	if cv == ConventionUnknown {
+		copy(results, inputs)
+		return results, nil
+	}
+
+	// unique holds the distinct inputs; positions[i] holds the positions of
+	// unique[i] in inputs
+	var unique []string
+	var positions [][]int
+	seen := make(map[string]int, len(inputs))
+	for i, input := range inputs {
+		if u, ok := seen[input]; ok {
+			positions[u] = append(positions[u], i)
+			continue
+		}
+		seen[input] = len(unique)
+		unique = append(unique, input)
+		positions = append(positions, []int{i})
+	}
+
+	convert := func(u int) {
+		res := opts.Converter.Convert(cv.request(unique[u], opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules))
+		for _, i := range positions[u] {
+			results[i] = res
+		}
+	}
+
+	workers := opts.Workers
+	if workers > len(unique) {
+		workers = len(unique)
+	}
+	if workers <= 1 {
+		for u := range unique {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			convert(u)
+		}
+		return results, nil
+	}
+
+	var next int64 = -1
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				u := int(atomic.AddInt64(&next, 1))
+				if u >= len(unique) {
+					return
+				}
+				convert(u)
+			}
+		}()
+	}
+	wg.Wait()
+	// each index which was taken has been converted
+	if next < int64(len(unique)-1) {
+		return nil, ctx.Err()
+	}
+	return results, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/chanced/caps"
+)
+
+func TestConvertAll(t *testing.T) {
+	var inputs []string
+	for i := 0; i < 1000; i++ {
+		inputs = append(inputs, fmt.Sprintf("fieldJSON%d", i%250))
+	}
+	for _, workers := range []int{0, 1, 3} {
+		t.Run(fmt.Sprint(workers), func(t *testing.T) {
+			counter := &countingConverter{Converter: caps.DefaultConverter}
+			got, err := caps.ConvertAll(context.Background(), inputs, caps.ConventionSnake, caps.WithWorkers(workers), caps.BatchOpts{
+				Opts: caps.WithConverter(counter),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, input := range inputs {
+				if expected := caps.ToSnake(input); got[i] != expected {
+					t.Fatalf("expected %q at %d, got %q", expected, i, got[i])
+				}
+			}
+			if counter.calls != 250 {
+				t.Errorf("expected 250 conversions, got %d", counter.calls)
+			}
+		})
+	}
+}
+
+func TestConvertAllUnknown(t *testing.T) {
+	inputs := []string{"userID", "user_id"}
+	got, err := caps.ConvertAll(context.Background(), inputs, caps.ConventionUnknown)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, inputs) {
+		t.Errorf("expected %v, got %v", inputs, got)
+	}
+	got, err = caps.ConvertAll(context.Background(), nil, caps.ConventionCamel)
+	if err != nil || len(got) != 0 {
+		t.Errorf("expected no results, got %v, %v", got, err)
+	}
+}
+
+type cancelingConverter struct {
+	caps.Converter
+	cancel context.CancelFunc
+	after  string
+}
+
+func (c cancelingConverter) Convert(req caps.ConvertRequest) string {
+	if req.Input == c.after {
+		c.cancel()
+	}
+	return c.Converter.Convert(req)
+}
+
+func TestConvertAllCanceled(t *testing.T) {
+	var inputs []string
+	for i := 0; i < 1000; i++ {
+		inputs = append(inputs, fmt.Sprintf("field_%d", i))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := caps.ConvertAll(ctx, inputs, caps.ConventionCamel); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	for _, workers := range []int{1, 4} {
+		ctx, cancel := context.WithCancel(context.Background())
+		converter := cancelingConverter{Converter: caps.DefaultConverter, cancel: cancel, after: "field_10"}
+		res, err := caps.ConvertAll(ctx, inputs, caps.ConventionCamel, caps.WithWorkers(workers), caps.BatchOpts{
+			Opts: caps.WithConverter(converter),
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled with %d workers, got %v", workers, err)
+		}
+		if res != nil {
+			t.Errorf("expected no results with %d workers, got %d", workers, len(res))
+		}
+	}
+}
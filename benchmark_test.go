@@ -25,9 +25,11 @@
 package caps_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/chanced/caps"
+	"github.com/chanced/caps/index"
 )
 
 var testCase string = "Example Uuid."
@@ -280,3 +282,144 @@ func BenchmarkWithShorterInput(b *testing.B) {
 		b.Fatalf("Expected %s, got %s", expected, s)
 	}
 }
+
+// -----------------------------------------------------------------------------
+// -----------------------------------------------------------------------------
+// 							Tokenizer benchmark
+// -----------------------------------------------------------------------------
+// -----------------------------------------------------------------------------
+
+var tokenizerBenchmarkInputs = []string{
+	"user_account_id",
+	"getUserHTTPResponseCode",
+	"AN_EXAMPLE_STRING with id 12.5",
+}
+
+func BenchmarkTokenizeASCII(b *testing.B) {
+	for _, input := range tokenizerBenchmarkInputs {
+		b.Run(input, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				caps.DefaultTokenizer.Tokenize(input, "", nil)
+			}
+		})
+	}
+}
+
+func BenchmarkTokenizeUnicode(b *testing.B) {
+	for _, input := range tokenizerBenchmarkInputs {
+		b.Run(input, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				caps.TokenizeUnicode(caps.DefaultTokenizer, input, "", nil)
+			}
+		})
+	}
+}
+
+func BenchmarkPlan(b *testing.B) {
+	plan := caps.Compile(caps.WithAllowedSymbols("$"))
+	for _, input := range tokenizerBenchmarkInputs {
+		b.Run(input, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				plan.ToSnake(input)
+			}
+		})
+	}
+}
+
+func BenchmarkCachedConverter(b *testing.B) {
+	opts := caps.WithConverter(caps.NewCachedConverter(caps.DefaultConverter, 0))
+	for _, input := range tokenizerBenchmarkInputs {
+		b.Run(input, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				caps.ToSnake(input, opts)
+			}
+		})
+	}
+}
+
+// benchmarkReplacements is a deterministic set of 10,000 distinct acronyms.
+var benchmarkReplacements = func() []caps.Replacement {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	replacements := make([]caps.Replacement, 0, 10000)
+	seen := make(map[string]bool, 10000)
+	for i := 1; len(replacements) < 10000; i++ {
+		n := i * 7919
+		var b strings.Builder
+		for l := 3 + i%6; l > 0; l-- {
+			b.WriteByte(letters[n%26])
+			n = n/26 + i
+		}
+		if w := b.String(); !seen[w] {
+			seen[w] = true
+			replacements = append(replacements, caps.Replacement{Camel: strings.ToUpper(w[:1]) + w[1:], Screaming: strings.ToUpper(w)})
+		}
+	}
+	return replacements
+}()
+
+func BenchmarkNewConverter(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		caps.NewConverter(benchmarkReplacements, caps.DefaultTokenizer, nil)
+	}
+}
+
+func BenchmarkNewConverterFromFrozen(b *testing.B) {
+	data, err := caps.NewConverter(benchmarkReplacements, caps.DefaultTokenizer, nil).Freeze().MarshalBinary()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		frozen := index.NewFrozen(nil)
+		if err := frozen.UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+		caps.NewConverterFromFrozen(frozen, caps.DefaultTokenizer, nil)
+	}
+}
+
+func BenchmarkCompile(b *testing.B) {
+	converter := caps.NewConverter(benchmarkReplacements, caps.DefaultTokenizer, nil)
+	for name, converter := range map[string]caps.StdConverter{
+		"trie":   converter,
+		"frozen": caps.NewConverterFromFrozen(converter.Freeze(), caps.DefaultTokenizer, nil),
+	} {
+		opts := caps.WithConverter(converter)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				caps.Compile(opts)
+			}
+		})
+	}
+}
+
+func BenchmarkFrozenConverter(b *testing.B) {
+	converter := caps.NewConverterFromFrozen(caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, nil).Freeze(), caps.DefaultTokenizer, nil)
+	opts := caps.WithConverter(converter)
+	for _, input := range tokenizerBenchmarkInputs {
+		b.Run(input, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				caps.ToCamel(input, opts)
+			}
+		})
+	}
+}
+
+func BenchmarkStdConverter(b *testing.B) {
+	for _, input := range tokenizerBenchmarkInputs {
+		b.Run(input, func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				caps.ToCamel(input)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+)
+
+// DefaultCacheSize is the size of a CachedConverter if one is not specified.
+const DefaultCacheSize = 1024
+
+// CacheStats are the metrics of a CachedConverter.
+type CacheStats struct {
+	// Hits is the number of conversions returned from the cache.
+	Hits uint64
+	// Misses is the number of conversions performed by the wrapped Converter.
+	Misses uint64
+	// Evictions is the number of entries removed to stay within Size.
+	Evictions uint64
+	// Len is the number of entries in the cache.
+	Len int
+	// Size is the maximum number of entries in the cache.
+	Size int
+}
+
+// CachedConverter is a Converter which caches the results of another
+// Converter, evicting the least recently used result once the cache is full.
+//
+// Results are keyed on the fields of the ConvertRequest. NumberRules are
+// compared by identity rather than by content, so requests should share the
+// same NumberRules (e.g. by using a Caps or Plan) for their results to be
+// cached; modifying NumberRules after they have been used does not
+// invalidate results.
+//
+// If the replacements of the wrapped Converter change, the cache must be
+// purged, either with Purge or by making the change through Set or Delete.
+//
+// CachedConverter is safe for concurrent use.
+type CachedConverter struct {
+	converter Converter
+	size      int
+
+	// convMu guards converter against changes made through Set and Delete
+	convMu sync.RWMutex
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+	stats   CacheStats
+	// generation is incremented on each purge so that results of conversions
+	// which started before it are not cached
+	generation uint64
+}
+
+type cacheKey struct {
+	style          Style
+	replaceStyle   ReplaceStyle
+	input          string
+	join           string
+	allowedSymbols string
+	numberRules    uintptr
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value string
+	// numberRules is kept so that the address used in key can not be reused by
+	// another map while the entry exists
+	numberRules NumberRules
+}
+
+// NewCachedConverter creates a new CachedConverter which caches up to size
+// results of converter.
+//
+// If converter is nil, DefaultConverter is used. If size is less than 1,
+// DefaultCacheSize is used.
+//
+// If converter is a StdConverter (such as DefaultConverter), a copy of it is
+// wrapped as a *StdConverter so that Set and Delete apply to it without
+// changing converter.
+func NewCachedConverter(converter Converter, size int) *CachedConverter {
+	if converter == nil {
+		converter = DefaultConverter
+	}
+	if sc, ok := converter.(StdConverter); ok {
+		// a StdConverter value can not be updated through Set and Delete and
+		// shares its replacements with the original
+		std := sc.clone()
+		converter = &std
+	}
+	if size < 1 {
+		size = DefaultCacheSize
+	}
+	return &CachedConverter{
+		converter: converter,
+		size:      size,
+		entries:   make(map[cacheKey]*list.Element, size),
+		lru:       list.New(),
+	}
+}
+
+func newCacheKey(req ConvertRequest) cacheKey {
+	key := cacheKey{
+		style:          req.Style,
+		replaceStyle:   req.ReplaceStyle,
+		input:          req.Input,
+		join:           req.Join,
+		allowedSymbols: req.AllowedSymbols,
+	}
+	if req.NumberRules != nil {
+		key.numberRules = reflect.ValueOf(req.NumberRules).Pointer()
+	}
+	return key
+}
+
+// Convert returns the cached result of req if there is one. Otherwise, req is
+// converted by the wrapped Converter and the result is cached.
+func (cc *CachedConverter) Convert(req ConvertRequest) string {
+	key := newCacheKey(req)
+	cc.mu.Lock()
+	if el, ok := cc.entries[key]; ok {
+		cc.lru.MoveToFront(el)
+		cc.stats.Hits++
+		cc.mu.Unlock()
+		return el.Value.(*cacheEntry).value
+	}
+	cc.stats.Misses++
+	generation := cc.generation
+	cc.mu.Unlock()
+
+	cc.convMu.RLock()
+	value := cc.converter.Convert(req)
+	cc.convMu.RUnlock()
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if generation != cc.generation {
+		return value
+	}
+	if el, ok := cc.entries[key]; ok {
+		// another goroutine converted req in the meantime
+		cc.lru.MoveToFront(el)
+		return value
+	}
+	cc.entries[key] = cc.lru.PushFront(&cacheEntry{
+		key:         key,
+		value:       value,
+		numberRules: req.NumberRules,
+	})
+	for cc.lru.Len() > cc.size {
+		el := cc.lru.Back()
+		cc.lru.Remove(el)
+		delete(cc.entries, el.Value.(*cacheEntry).key)
+		cc.stats.Evictions++
+	}
+	return value
+}
+
+// Converter returns the wrapped Converter.
+//
+// If the CachedConverter was created with a StdConverter, this is a
+// *StdConverter holding a copy of it.
+func (cc *CachedConverter) Converter() Converter {
+	return cc.converter
+}
+
+// Stats returns the metrics of cc.
+func (cc *CachedConverter) Stats() CacheStats {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	stats := cc.stats
+	stats.Len = cc.lru.Len()
+	stats.Size = cc.size
+	return stats
+}
+
+// Purge removes all results from the cache. Metrics are not reset.
+func (cc *CachedConverter) Purge() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.purge()
+}
+
+func (cc *CachedConverter) purge() {
+	cc.generation++
+	cc.entries = make(map[cacheKey]*list.Element, cc.size)
+	cc.lru.Init()
+}
+
+// Set adds the key/value pair to the replacements of the wrapped Converter if
+// it has a Set method (e.g. *StdConverter) and purges the cache.
+//
+// Set waits for conversions in progress to complete.
+func (cc *CachedConverter) Set(key, value string) {
+	cc.update(func() {
+		if s, ok := cc.converter.(interface{ Set(key, value string) }); ok {
+			s.Set(key, value)
+		}
+	})
+}
+
+// Delete removes key from the replacements of the wrapped Converter if it has
+// a Delete method (e.g. *StdConverter) and purges the cache.
+//
+// Delete waits for conversions in progress to complete.
+func (cc *CachedConverter) Delete(key string) {
+	cc.update(func() {
+		if d, ok := cc.converter.(interface{ Delete(key string) }); ok {
+			d.Delete(key)
+		}
+	})
+}
+
+func (cc *CachedConverter) update(fn func()) {
+	cc.convMu.Lock()
+	defer cc.convMu.Unlock()
+	fn()
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.purge()
+}
+
+var _ Converter = (*CachedConverter)(nil)
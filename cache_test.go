@@ -0,0 +1,179 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/chanced/caps"
+	"github.com/chanced/caps/token"
+)
+
+type countingConverter struct {
+	caps.Converter
+	mu    sync.Mutex
+	calls int
+}
+
+func (c *countingConverter) Convert(req caps.ConvertRequest) string {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+	return c.Converter.Convert(req)
+}
+
+func TestCachedConverter(t *testing.T) {
+	counter := &countingConverter{Converter: caps.DefaultConverter}
+	cc := caps.NewCachedConverter(counter, 2)
+	opts := caps.WithConverter(cc)
+
+	if got := caps.ToCamel("user_id", opts); got != "UserID" {
+		t.Errorf("expected %q, got %q", "UserID", got)
+	}
+	if got := caps.ToCamel("user_id", opts); got != "UserID" {
+		t.Errorf("expected %q, got %q", "UserID", got)
+	}
+	if got := caps.ToSnake("user_id", opts); got != "user_id" {
+		t.Errorf("expected %q, got %q", "user_id", got)
+	}
+	if counter.calls != 2 {
+		t.Errorf("expected 2 calls to the wrapped converter, got %d", counter.calls)
+	}
+
+	// "user_id" to camel is the most recently used and is kept
+	caps.ToCamel("user_id", opts)
+	caps.ToKebab("user_id", opts)
+	caps.ToCamel("user_id", opts)
+	if counter.calls != 3 {
+		t.Errorf("expected 3 calls to the wrapped converter, got %d", counter.calls)
+	}
+	caps.ToSnake("user_id", opts)
+	if counter.calls != 4 {
+		t.Errorf("expected 4 calls to the wrapped converter, got %d", counter.calls)
+	}
+
+	expected := caps.CacheStats{Hits: 3, Misses: 4, Evictions: 2, Len: 2, Size: 2}
+	if stats := cc.Stats(); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+	cc.Purge()
+	if stats := cc.Stats(); stats.Len != 0 {
+		t.Errorf("expected an empty cache, got %d entries", stats.Len)
+	}
+}
+
+func TestCachedConverterNumberRules(t *testing.T) {
+	counter := &countingConverter{Converter: caps.DefaultConverter}
+	cc := caps.NewCachedConverter(counter, 0)
+	c := caps.New(caps.Config{
+		Converter:      cc,
+		AllowedSymbols: "$",
+		NumberRules:    token.NumberRules{'$': func(i int, r rune, val string) bool { return i == 0 }},
+	})
+	for i := 0; i < 3; i++ {
+		if got := c.ToSnake("price_$12"); got != "price_$12" {
+			t.Errorf("expected %q, got %q", "price_$12", got)
+		}
+	}
+	if got := caps.ToSnake("price_$12", caps.WithConverter(cc), caps.WithAllowedSymbols("$")); got != "price_$_12" {
+		t.Errorf("expected %q, got %q", "price_$_12", got)
+	}
+	if counter.calls != 2 {
+		t.Errorf("expected 2 calls to the wrapped converter, got %d", counter.calls)
+	}
+	if size := cc.Stats().Size; size != caps.DefaultCacheSize {
+		t.Errorf("expected size %d, got %d", caps.DefaultCacheSize, size)
+	}
+}
+
+func TestCachedConverterSet(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, token.DefaultCaser)
+	cc := caps.NewCachedConverter(&converter, 10)
+	opts := caps.WithConverter(cc)
+
+	if got := caps.ToCamel("foo_id", opts); got != "FooID" {
+		t.Errorf("expected %q, got %q", "FooID", got)
+	}
+	cc.Set("Foo", "FOO")
+	if got := caps.ToCamel("foo_id", opts); got != "FOOID" {
+		t.Errorf("expected %q, got %q", "FOOID", got)
+	}
+	cc.Delete("Id")
+	if got := caps.ToCamel("foo_id", opts); got != "FOOId" {
+		t.Errorf("expected %q, got %q", "FOOId", got)
+	}
+}
+
+func TestCachedConverterConcurrent(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, token.DefaultCaser)
+	cc := caps.NewCachedConverter(&converter, 16)
+	opts := caps.WithConverter(cc)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				input := fmt.Sprintf("field_%d_json", i%32)
+				expected := fmt.Sprintf("Field%dJSON", i%32)
+				if g == 0 && i%100 == 0 {
+					cc.Set("Bar", "BAR")
+				}
+				if got := caps.ToCamel(input, opts); got != expected {
+					t.Errorf("expected %q, got %q", expected, got)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	stats := cc.Stats()
+	if stats.Hits+stats.Misses != 8*500 {
+		t.Errorf("expected %d conversions, got %d", 8*500, stats.Hits+stats.Misses)
+	}
+	if stats.Len > stats.Size {
+		t.Errorf("expected at most %d entries, got %d", stats.Size, stats.Len)
+	}
+}
+
+func TestCachedConverterSetDefault(t *testing.T) {
+	cc := caps.NewCachedConverter(nil, 10)
+	opts := caps.WithConverter(cc)
+
+	cc.Set("Foo", "FOO")
+	if got := caps.ToCamel("foo_id", opts); got != "FOOID" {
+		t.Errorf("expected %q, got %q", "FOOID", got)
+	}
+	cc.Delete("Id")
+	if got := caps.ToCamel("foo_id", opts); got != "FOOId" {
+		t.Errorf("expected %q, got %q", "FOOId", got)
+	}
+	// the DefaultConverter is unchanged
+	if got := caps.ToCamel("foo_id"); got != "FooID" {
+		t.Errorf("expected DefaultConverter to return %q, got %q", "FooID", got)
+	}
+}
@@ -39,6 +39,10 @@ type Caps struct {
 	converter      Converter
 	replaceStyle   ReplaceStyle
 	numberRules    token.NumberRules
+	graphemes      bool
+	titleStyle     TitleStyle
+	properNouns    []string
+	tokenizer      Tokenizer
 }
 
 // New returns a new Caps instance with the provided options.
@@ -54,6 +58,10 @@ func New(options ...Config) Caps {
 		converter:      opts.Converter,
 		replaceStyle:   opts.ReplaceStyle,
 		numberRules:    opts.NumberRules,
+		graphemes:      opts.Graphemes,
+		titleStyle:     opts.TitleStyle,
+		properNouns:    opts.ProperNouns,
+		tokenizer:      opts.Tokenizer,
 	}
 }
 
@@ -72,20 +80,64 @@ func (c Caps) AllowedSymbols() string {
 	return c.allowedSymbols
 }
 
+// Graphemes reports whether c operates on extended grapheme clusters
+func (c Caps) Graphemes() bool {
+	return c.graphemes
+}
+
+// TitleStyle returns the configured TitleStyle of c
+func (c Caps) TitleStyle() TitleStyle {
+	return c.titleStyle
+}
+
+// ProperNouns returns the configured ProperNouns of c
+func (c Caps) ProperNouns() []string {
+	return c.properNouns
+}
+
+// Tokenizer returns the configured Tokenizer of c
+//
+// If c was configured with a Converter, the Tokenizer may differ from the one
+// used by the Converter.
+func (c Caps) Tokenizer() Tokenizer {
+	if c.tokenizer == nil {
+		return DefaultTokenizer
+	}
+	return c.tokenizer
+}
+
 // Converter returns the provided Converter of c
 func (c Caps) Converter() Converter {
 	return c.converter
 }
 
+// Compile returns a Plan with the configuration of c. See Plan for more
+// information.
+func (c Caps) Compile() *Plan {
+	return newPlan(c.converter, c.replaceStyle, c.allowedSymbols, c.numberRules, c.titleStyle, c.caser)
+}
+
 // UpperFirst converts the first rune of str to unicode upper case.
 //
 // This method does not support special cases (such as Turkish and Azeri)
+//
+// If c was configured with Graphemes, the first letter of the first grapheme
+// cluster is converted instead.
 func (c Caps) UpperFirst(str string) string {
+	if c.graphemes {
+		return token.UpperFirstGrapheme(c.caser, str)
+	}
 	return token.UpperFirst(c.caser, str)
 }
 
 // LowerFirst converts the first rune of str to lowercase.
+//
+// If c was configured with Graphemes, the first letter of the first grapheme
+// cluster is converted instead.
 func (c Caps) LowerFirst(str string) string {
+	if c.graphemes {
+		return token.LowerFirstGrapheme(c.caser, str)
+	}
 	return token.LowerFirst(c.caser, str)
 }
 
@@ -197,16 +249,83 @@ func (c Caps) ToScreamingDotNotation(str string) string {
 // ToTitle transforms the case of str into Title Case (e.g. An Example String) using
 // either the provided Converter or the DefaultConverter otherwise.
 //
+// If c was configured with a TitleStyle, small words are lowercased according
+// to the style guide.
+//
 //	caps.ToTitle("This is [an] {example}${id32}.") // This Is An Example ID 32
 func (c Caps) ToTitle(str string) string {
-	return c.converter.Convert(ConvertRequest{
+	return applyTitleStyle(c.caser, c.titleStyle, c.converter.Convert(ConvertRequest{
 		Style:          StyleCamel,
 		ReplaceStyle:   c.replaceStyle,
 		Input:          str,
 		Join:           " ",
+		AllowedSymbols: titleSymbols(c.titleStyle, c.allowedSymbols),
+		NumberRules:    c.numberRules,
+	}))
+}
+
+// ToInitialism returns the initialism of str (e.g. "Application Programming
+// Interface" becomes "API") using the Converter, AllowedSymbols, and
+// NumberRules of c.
+//
+// See the package level ToInitialism for more information.
+func (c Caps) ToInitialism(str string, options ...InitialismOpts) string {
+	opts := append([]InitialismOpts{{Opts: Opts{
+		Converter:      c.converter,
 		AllowedSymbols: c.allowedSymbols,
 		NumberRules:    c.numberRules,
-	})
+	}}}, options...)
+	return ToInitialism(str, opts...)
+}
+
+// ToSlug transforms str into an ASCII slug suitable for URLs and identifiers
+// (e.g. an-example-string) using the Converter, AllowedSymbols, and
+// NumberRules of c.
+//
+// See the package level ToSlug for more information.
+//
+//	caps.ToSlug("Crème Brûlée Café") // creme-brulee-cafe
+func (c Caps) ToSlug(str string, options ...SlugOpts) string {
+	opts := append([]SlugOpts{{Opts: Opts{
+		Converter:      c.converter,
+		AllowedSymbols: c.allowedSymbols,
+		NumberRules:    c.numberRules,
+		ReplaceStyle:   c.replaceStyle,
+	}}}, options...)
+	return ToSlug(str, opts...)
+}
+
+// NormalizeKey returns the normalized identifier of str using the Converter,
+// AllowedSymbols, and NumberRules of c.
+//
+// See the package level NormalizeKey for more information.
+//
+//	caps.NormalizeKey("UserID") // user_id
+func (c Caps) NormalizeKey(str string) string {
+	return normalizeKey(c.converter, str, c.allowedSymbols, c.numberRules)
+}
+
+// EqualIdent reports whether a and b name the same identifier, regardless of
+// their convention and case, using the Converter, AllowedSymbols, and
+// NumberRules of c.
+//
+//	caps.EqualIdent("user_id", "UserID") // true
+func (c Caps) EqualIdent(a, b string) bool {
+	return c.NormalizeKey(a) == c.NormalizeKey(b)
+}
+
+// ToSentence transforms the case of str into Sentence case (e.g. An example
+// string) using either the provided Converter or the DefaultConverter
+// otherwise.
+//
+// Only the first word is capitalized. Replacements are written in their
+// screaming form (e.g. "ID"), unless the ReplaceStyle specifies otherwise, and
+// words matching the configured ProperNouns are written as they appear in
+// ProperNouns.
+//
+//	caps.ToSentence("userIDMustBeSet") // User ID must be set
+func (c Caps) ToSentence(str string) string {
+	return toSentence(c.converter, c.caser, str, c.replaceStyle, c.allowedSymbols, c.numberRules, c.properNouns)
 }
 
 // ToDelimited transforms the case of str into a string separated by delimiter,
@@ -240,3 +359,33 @@ func (c Caps) ToDelimited(str string, delimiter string, lowercase bool) string {
 		NumberRules:    c.numberRules,
 	})
 }
+
+// ToConvention transforms the case of str into the naming Convention cv.
+//
+// If cv is ConventionUnknown, str is returned unchanged.
+//
+//	caps.New().ToConvention("user_id", caps.ConventionCamel) // UserID
+func (c Caps) ToConvention(str string, cv Convention) string {
+	if cv == ConventionUnknown {
+		return str
+	}
+	return c.converter.Convert(cv.request(str, c.replaceStyle, c.allowedSymbols, c.numberRules))
+}
+
+// RoundTrips reports whether str, which is in the naming Convention from, is
+// unchanged after being converted to the Convention to and back to from.
+//
+//	caps.New().RoundTrips("userId", caps.ConventionLowerCamel, caps.ConventionSnake) // false
+func (c Caps) RoundTrips(str string, from, to Convention) bool {
+	_, err := convertStrict(c.converter, str, from, to, c.replaceStyle, c.allowedSymbols, c.numberRules)
+	return err == nil
+}
+
+// ToConventionStrict transforms the case of str into the naming Convention
+// cv, returning a *LossError if str does not round trip or
+// ErrUnknownConvention if the Convention of str can not be detected.
+//
+//	caps.New().ToConventionStrict("userId", caps.ConventionSnake) // user_id, *LossError
+func (c Caps) ToConventionStrict(str string, cv Convention) (string, error) {
+	return convertStrict(c.converter, str, DetectConvention(str), cv, c.replaceStyle, c.allowedSymbols, c.numberRules)
+}
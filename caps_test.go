@@ -119,6 +119,102 @@ func TestToTitle(t *testing.T) {
 	}
 }
 
+var (
+	ap      = caps.WithTitleStyle(caps.TitleStyleAP)
+	chicago = caps.WithTitleStyle(caps.TitleStyleChicago)
+	apa     = caps.WithTitleStyle(caps.TitleStyleAPA)
+	mla     = caps.WithTitleStyle(caps.TitleStyleMLA)
+)
+
+var titleStyleTestCases = testcases{
+	{"the lord of the rings", "The Lord of the Rings", Opts{ap}},
+	{"the lord of the rings", "The Lord of the Rings", Opts{chicago}},
+	{"the lord of the rings", "The Lord of the Rings", Opts{apa}},
+	{"the lord of the rings", "The Lord of the Rings", Opts{mla}},
+	{"a tale of two cities", "A Tale of Two Cities", Opts{chicago}},
+	{"what dreams are made of", "What Dreams Are Made Of", Opts{ap}},
+	{"what dreams are made of", "What Dreams Are Made Of", Opts{chicago}},
+	{"what dreams are made of", "What Dreams Are Made of", Opts{apa}},
+	{"a walk through the park", "A Walk Through the Park", Opts{ap}},
+	{"a walk through the park", "A Walk through the Park", Opts{chicago}},
+	{"a walk through the park", "A Walk Through the Park", Opts{apa}},
+	{"a walk through the park", "A Walk through the Park", Opts{mla}},
+	{"slow and steady so it wins", "Slow and Steady so It Wins", Opts{ap}},
+	{"slow and steady so it wins", "Slow and Steady So It Wins", Opts{chicago}},
+	{"slow and steady so it wins", "Slow and Steady so It Wins", Opts{mla}},
+	{"star wars: a new hope", "Star Wars: A New Hope", Opts{chicago, caps.WithAllowedSymbols(":")}},
+	{"the end of: the beginning", "The End Of: The Beginning", Opts{ap, caps.WithAllowedSymbols(":")}},
+	{"the end of: the beginning", "The End of: The Beginning", Opts{apa, caps.WithAllowedSymbols(":")}},
+	{"star wars: the empire", "Star Wars: The Empire", Opts{ap}},
+	{"the end of: the beginning", "The End of: The Beginning", Opts{apa}},
+	{"state-of-the-art design", "State-of-the-Art Design", Opts{chicago, caps.WithAllowedSymbols("-")}},
+	{"the user id of the http request", "The User ID of the HTTP Request", Opts{ap}},
+	{"the_user_id_of_the_request", "The User ID of the Request", Opts{mla}},
+	{"the user id of the request", "The User Id of the Request", Opts{chicago, caps.WithReplaceStyleCamel()}},
+}
+
+func TestToTitleStyle(t *testing.T) {
+	for _, test := range titleStyleTestCases {
+		func(test testcase) {
+			t.Run(test.input, func(t *testing.T) {
+				t.Parallel()
+				output := caps.ToTitle(test.input, test.opts...)
+				if output != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, output)
+				}
+			})
+		}(test)
+		func(test testcase) {
+			t.Run("Caps::"+test.input, func(t *testing.T) {
+				t.Parallel()
+				c := caps.New(test.opts.toConfig())
+				output := c.ToTitle(test.input)
+				if output != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, output)
+				}
+			})
+		}(test)
+	}
+}
+
+var sentenceTestCases = testcases{
+	{"", "", nil},
+	{"a", "A", nil},
+	{"userIDMustBeSet", "User ID must be set", nil},
+	{"user_id_must_be_set", "User ID must be set", nil},
+	{"http_request_url_is_invalid", "HTTP request URL is invalid", nil},
+	{"THE_NAME_IS_REQUIRED", "The name is required", nil},
+	{"user id must be set", "User id must be set", Opts{caps.WithReplaceStyleLower()}},
+	{"github_user_not_found", "GitHub user not found", Opts{caps.WithProperNouns("GitHub")}},
+	{"address_in_new_york", "Address in New York", Opts{caps.WithProperNouns("GitHub", "New York")}},
+	{"new_york_address", "New York address", Opts{caps.WithProperNouns("New York")}},
+	{"amount must be -1", "Amount must be -1", Opts{caps.WithAllowedSymbols("-")}},
+}
+
+func TestToSentence(t *testing.T) {
+	for _, test := range sentenceTestCases {
+		func(test testcase) {
+			t.Run(test.input, func(t *testing.T) {
+				t.Parallel()
+				output := caps.ToSentence(test.input, test.opts...)
+				if output != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, output)
+				}
+			})
+		}(test)
+		func(test testcase) {
+			t.Run("Caps::"+test.input, func(t *testing.T) {
+				t.Parallel()
+				c := caps.New(test.opts.toConfig())
+				output := c.ToSentence(test.input)
+				if output != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, output)
+				}
+			})
+		}(test)
+	}
+}
+
 var camelTestCases = testcases{
 	{"", "", nil},
 	{"a", "A", nil},
@@ -493,6 +589,31 @@ func TestCapsAccessors(t *testing.T) {
 			t.Errorf("expected \"$\", got %v", c.AllowedSymbols())
 		}
 	})
+	t.Run("Tokenizer", func(t *testing.T) {
+		t.Parallel()
+		c := caps.New()
+		if _, ok := c.Tokenizer().(caps.StdTokenizer); !ok {
+			t.Errorf("expected a StdTokenizer, got %T", c.Tokenizer())
+		}
+		tokenizer := caps.NewTokenizer("_", token.DefaultCaser)
+		c = caps.New(caps.Config{Tokenizer: tokenizer})
+		if tokens := c.Tokenizer().Tokenize("a-b_c", "", nil); len(tokens) != 2 {
+			t.Errorf("expected the configured Tokenizer, got %v", tokens)
+		}
+	})
+	t.Run("TitleStyle", func(t *testing.T) {
+		t.Parallel()
+		c := caps.New()
+		if ts := c.TitleStyle(); ts != caps.TitleStyleNotSpecified {
+			t.Errorf("expected %s, got %s", caps.TitleStyleNotSpecified, ts)
+		}
+		c = caps.New(caps.Config{
+			TitleStyle: caps.TitleStyleAPA,
+		})
+		if ts := c.TitleStyle(); ts != caps.TitleStyleAPA {
+			t.Errorf("expected %s, got %s", caps.TitleStyleAPA, ts)
+		}
+	})
 }
 
 func TestToUpper(t *testing.T) {
@@ -603,6 +724,76 @@ func TestUpperFirst(t *testing.T) {
 	}
 }
 
+func TestGraphemes(t *testing.T) {
+	c := caps.New(caps.Config{Graphemes: true})
+	if !c.Graphemes() {
+		t.Error("expected c.Graphemes() to return true")
+	}
+	tests := []struct {
+		input    string
+		expected string
+		fn       func(string) string
+	}{
+		{"\u0600abc", "\u0600Abc", c.UpperFirst},
+		{"\u0600ABC", "\u0600aBC", c.LowerFirst},
+		{"Cre\u0300meBru\u0302le\u0301e", "cre\u0300me_bru\u0302le\u0301e", c.ToSnake},
+		{"cre\u0300me bru\u0302le\u0301e", "Cre\u0300meBru\u0302le\u0301e", c.ToCamel},
+		{"E\u0301TE\u0301 BRU\u0302LE\u0301E", "E\u0301te\u0301 Bru\u0302le\u0301e", c.ToTitle},
+		{"\u0600abc def", "\u0600AbcDef", c.ToCamel},
+		{"abc \u0600def", "abc\u0600Def", c.ToLowerCamel},
+		{"\u0600abc \u0600def", "\u0600Abc \u0600Def", c.ToTitle},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			output := test.fn(test.input)
+			if output != test.expected {
+				t.Errorf("expected %+q, got %+q", test.expected, output)
+			}
+		})
+	}
+}
+
+func TestToSlug(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		opts     []caps.SlugOpts
+	}{
+		{"Crème Brûlée Café", "creme-brulee-cafe", nil},
+		{"Łódź", "lodz", nil},
+		{"Cre\u0300me Bru\u0302le\u0301e", "creme-brulee", nil},
+		{"Жуков и Ёлкин", "zhukov-i-yolkin", nil},
+		{"Αθήνα 2004", "athina-2004", nil},
+		{"  Hello,   World!!  ", "hello-world", nil},
+		{"Don't stop/believing", "dont-stop-believing", nil},
+		{"rock & roll", "rock-roll", nil},
+		{"東京 Tokyo", "tokyo", nil},
+		{"ServeJSON", "serve-json", nil},
+		{"Ærøskøbing", "aeroskobing", nil},
+		{"Œuvre", "oeuvre", nil},
+		{"STRAẞE", "strasse", nil},
+		{"Crème Brûlée", "CREME_BRULEE", []caps.SlugOpts{{Style: caps.StyleScreaming, Delimiter: "_"}}},
+		{"Crème Brûlée", "Creme-Brulee", []caps.SlugOpts{{Style: caps.StyleCamel}}},
+		{"Don't stop/believing", "dont-stop", []caps.SlugOpts{{MaxLen: 10}}},
+		{"Don't stop/believing", "dont-stop", []caps.SlugOpts{{MaxLen: 9}}},
+		{"Don't stop/believing", "dont", []caps.SlugOpts{{MaxLen: 8}}},
+		{"Supercalifragilistic", "supercal", []caps.SlugOpts{{MaxLen: 8}}},
+		{"a.b", "a.b", []caps.SlugOpts{{Opts: caps.Opts{AllowedSymbols: "."}}}},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			output := caps.ToSlug(test.input, test.opts...)
+			if output != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, output)
+			}
+		})
+	}
+	c := caps.New(caps.Config{Replacements: []caps.Replacement{{Camel: "Brulee", Screaming: "BRULEE"}}})
+	if output := c.ToSlug("Crème Brûlée", caps.SlugOpts{Delimiter: "_"}); output != "creme_brulee" {
+		t.Errorf("expected %q, got %q", "creme_brulee", output)
+	}
+}
+
 func TestFormatToken(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -643,6 +834,12 @@ func loadOpts(opts Opts) caps.Opts {
 		if opt.Converter != nil {
 			result.Converter = opt.Converter
 		}
+		if opt.TitleStyle != 0 {
+			result.TitleStyle = opt.TitleStyle
+		}
+		if opt.ProperNouns != nil {
+			result.ProperNouns = append(result.ProperNouns, opt.ProperNouns...)
+		}
 	}
 	return result
 }
@@ -654,5 +851,7 @@ func (o Opts) toConfig() caps.Config {
 	capopts.NumberRules = opts.NumberRules
 	capopts.ReplaceStyle = opts.ReplaceStyle
 	capopts.Converter = opts.Converter
+	capopts.TitleStyle = opts.TitleStyle
+	capopts.ProperNouns = opts.ProperNouns
 	return capopts
 }
@@ -0,0 +1,451 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrUnknownConvention is returned by StrictConverter when the naming
+// convention of the input can not be detected.
+var ErrUnknownConvention = errors.New("caps: unknown naming convention")
+
+// Convention is a naming convention, the combination of a Style and a
+// delimiter used to join words (e.g. snake_case).
+type Convention uint8
+
+const (
+	ConventionUnknown Convention = iota
+	// AnExampleString
+	ConventionCamel
+	// anExampleString
+	ConventionLowerCamel
+	// an_example_string
+	ConventionSnake
+	// AN_EXAMPLE_STRING
+	ConventionScreamingSnake
+	// an-example-string
+	ConventionKebab
+	// AN-EXAMPLE-STRING
+	ConventionScreamingKebab
+	// an.example.string
+	ConventionDotNotation
+	// AN.EXAMPLE.STRING
+	ConventionScreamingDotNotation
+)
+
+func (c Convention) String() string {
+	switch c {
+	case ConventionCamel:
+		return "ConventionCamel"
+	case ConventionLowerCamel:
+		return "ConventionLowerCamel"
+	case ConventionSnake:
+		return "ConventionSnake"
+	case ConventionScreamingSnake:
+		return "ConventionScreamingSnake"
+	case ConventionKebab:
+		return "ConventionKebab"
+	case ConventionScreamingKebab:
+		return "ConventionScreamingKebab"
+	case ConventionDotNotation:
+		return "ConventionDotNotation"
+	case ConventionScreamingDotNotation:
+		return "ConventionScreamingDotNotation"
+	}
+	return "ConventionUnknown"
+}
+
+// request returns the ConvertRequest which converts input to c.
+//
+// As with ToDelimited, replaceStyle only applies to camel conventions.
+func (c Convention) request(input string, replaceStyle ReplaceStyle, allowedSymbols string, numberRules NumberRules) ConvertRequest {
+	req := ConvertRequest{
+		Input:          input,
+		AllowedSymbols: allowedSymbols,
+		NumberRules:    numberRules,
+	}
+	switch c {
+	case ConventionCamel, ConventionLowerCamel:
+		req.Style = StyleCamel
+		if c == ConventionLowerCamel {
+			req.Style = StyleLowerCamel
+		}
+		req.ReplaceStyle = replaceStyle
+		return req
+	case ConventionSnake, ConventionScreamingSnake:
+		req.Join = "_"
+	case ConventionKebab, ConventionScreamingKebab:
+		req.Join = "-"
+	case ConventionDotNotation, ConventionScreamingDotNotation:
+		req.Join = "."
+	}
+	switch c {
+	case ConventionScreamingSnake, ConventionScreamingKebab, ConventionScreamingDotNotation:
+		req.Style = StyleScreaming
+		req.ReplaceStyle = ReplaceStyleScreaming
+	default:
+		req.Style = StyleLower
+		req.ReplaceStyle = ReplaceStyleLower
+	}
+	return req
+}
+
+// DetectConvention returns the naming Convention of str or ConventionUnknown
+// if str is empty, contains symbols other than a single kind of delimiter, or
+// mixes cases within a delimited convention.
+//
+// A single word is reported as ConventionLowerCamel if lowercase,
+// ConventionCamel if capitalized, and ConventionScreamingSnake if uppercase.
+//
+//	caps.DetectConvention("userID") // ConventionLowerCamel
+//	caps.DetectConvention("USER_ID") // ConventionScreamingSnake
+//	caps.DetectConvention("user id") // ConventionUnknown
+func DetectConvention[T ~string](str T) Convention {
+	var delimiter rune
+	var hasUpper, hasLower, firstUpper, hasLetter bool
+	for _, r := range string(str) {
+		switch {
+		case r == '_' || r == '-' || r == '.':
+			if delimiter != 0 && delimiter != r {
+				return ConventionUnknown
+			}
+			delimiter = r
+		case unicode.IsUpper(r):
+			if !hasLetter {
+				firstUpper = true
+			}
+			hasUpper, hasLetter = true, true
+		case unicode.IsLetter(r):
+			hasLower, hasLetter = true, true
+		case unicode.IsNumber(r):
+		default:
+			return ConventionUnknown
+		}
+	}
+	if !hasLetter {
+		return ConventionUnknown
+	}
+	if delimiter == 0 {
+		switch {
+		case !hasLower:
+			return ConventionScreamingSnake
+		case firstUpper:
+			return ConventionCamel
+		default:
+			return ConventionLowerCamel
+		}
+	}
+	if hasUpper && hasLower {
+		return ConventionUnknown
+	}
+	switch delimiter {
+	case '_':
+		if hasUpper {
+			return ConventionScreamingSnake
+		}
+		return ConventionSnake
+	case '-':
+		if hasUpper {
+			return ConventionScreamingKebab
+		}
+		return ConventionKebab
+	default:
+		if hasUpper {
+			return ConventionScreamingDotNotation
+		}
+		return ConventionDotNotation
+	}
+}
+
+// ToConvention transforms the case of str into the naming Convention c using
+// either the provided Converter or the DefaultConverter otherwise.
+//
+// If c is ConventionUnknown, str is returned unchanged.
+//
+//	caps.ToConvention("user_id", caps.ConventionCamel) // UserID
+func ToConvention[T ~string](str T, c Convention, options ...Opts) T {
+	if c == ConventionUnknown {
+		return str
+	}
+	opts := loadOpts(options)
+	return T(opts.Converter.Convert(c.request(string(str), opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules)))
+}
+
+// RoundTrips reports whether str, which is in the naming Convention from, is
+// unchanged after being converted to the Convention to and back to from, and
+// whether the converted string is made of the same words as str.
+//
+// A conversion which does not round trip loses information, such as the
+// casing of an acronym (e.g. "userId" to "user_id" to "userID"). A conversion
+// which regroups the words of str, such as splitting an acronym (e.g.
+// "UserIDs" to "user_i_ds") or detaching digits from a word (e.g. "Sha256" to
+// "sha_256"), loses information even if it round trips.
+//
+//	caps.RoundTrips("userID", caps.ConventionLowerCamel, caps.ConventionSnake) // true
+//	caps.RoundTrips("userId", caps.ConventionLowerCamel, caps.ConventionSnake) // false
+//	caps.RoundTrips("UserIDs", caps.ConventionCamel, caps.ConventionSnake) // false
+func RoundTrips[T ~string](str T, from, to Convention, options ...Opts) bool {
+	opts := loadOpts(options)
+	_, err := convertStrict(opts.Converter, string(str), from, to, opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules)
+	return err == nil
+}
+
+// ToConventionStrict transforms the case of str into the naming Convention c
+// using either the provided Converter or the DefaultConverter otherwise.
+//
+// The Convention of str is detected with DetectConvention. If str does not
+// round trip (see RoundTrips), the converted string is returned along with a
+// *LossError. If the Convention of str can not be detected,
+// ErrUnknownConvention is returned.
+//
+//	caps.ToConventionStrict("userID", caps.ConventionSnake) // user_id, nil
+//	caps.ToConventionStrict("userId", caps.ConventionSnake) // user_id, *LossError
+func ToConventionStrict[T ~string](str T, c Convention, options ...Opts) (T, error) {
+	opts := loadOpts(options)
+	res, err := convertStrict(opts.Converter, string(str), DetectConvention(str), c, opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules)
+	return T(res), err
+}
+
+// LossError is returned by strict conversions when converting Input to the
+// Convention To and back to the Convention From does not result in Input, or
+// when Output is not made of the same words as Input.
+type LossError struct {
+	Input string
+	// Output is the result of converting Input to To.
+	Output string
+	// RoundTrip is the result of converting Output back to From.
+	RoundTrip string
+	From      Convention
+	// To is ConventionUnknown if the request of StrictConverter.ConvertE does
+	// not produce a Convention (e.g. title case).
+	To Convention
+}
+
+func (e *LossError) Error() string {
+	if e.RoundTrip == e.Input {
+		return fmt.Sprintf("caps: converting %q to %s is lossy: %q does not keep the words of the input", e.Input, e.To, e.Output)
+	}
+	return fmt.Sprintf("caps: converting %q to %s is lossy: %q converts back to %q", e.Input, e.To, e.Output, e.RoundTrip)
+}
+
+func convertStrict(converter Converter, input string, from, to Convention, replaceStyle ReplaceStyle, allowedSymbols string, numberRules NumberRules) (string, error) {
+	if from == ConventionUnknown || to == ConventionUnknown {
+		return input, ErrUnknownConvention
+	}
+	out := converter.Convert(to.request(input, replaceStyle, allowedSymbols, numberRules))
+	return out, checkLoss(converter, input, out, from, to, replaceStyle, allowedSymbols, numberRules)
+}
+
+// checkLoss returns a *LossError if out, the result of converting input from
+// the Convention from to the Convention to, does not convert back to input or
+// does not consist of the same words as input.
+func checkLoss(converter Converter, input, out string, from, to Convention, replaceStyle ReplaceStyle, allowedSymbols string, numberRules NumberRules) error {
+	back := converter.Convert(from.request(out, replaceStyle, allowedSymbols, numberRules))
+	if back != input || !sameWords(converter, input, out, allowedSymbols, numberRules) {
+		return &LossError{Input: input, Output: out, RoundTrip: back, From: from, To: to}
+	}
+	return nil
+}
+
+// wordSep joins the words of a string split by splitWords.
+const wordSep = "\x00"
+
+// splitWords splits str into its lowercase words as determined by converter.
+func splitWords(converter Converter, str string, allowedSymbols string, numberRules NumberRules) []string {
+	return strings.Split(converter.Convert(ConvertRequest{
+		Style:          StyleLower,
+		ReplaceStyle:   ReplaceStyleLower,
+		Input:          str,
+		Join:           wordSep,
+		AllowedSymbols: allowedSymbols,
+		NumberRules:    numberRules,
+	}), wordSep)
+}
+
+// sameWords reports whether input and out are split into the same words and
+// whether each of them is split only where the words are visibly separated.
+//
+// A conversion which round trips can still regroup the words of the input,
+// such as splitting an acronym ("UserIDs" to "user_i_ds") or detaching digits
+// from a word ("Sha256" to "sha_256").
+func sameWords(converter Converter, input, out string, allowedSymbols string, numberRules NumberRules) bool {
+	in := splitWords(converter, input, allowedSymbols, numberRules)
+	words := splitWords(converter, out, allowedSymbols, numberRules)
+	if len(in) != len(words) {
+		return false
+	}
+	for i, w := range in {
+		if foldString(w) != foldString(words[i]) {
+			return false
+		}
+	}
+	return separated(input, in) && separated(out, words)
+}
+
+// separated reports whether each pair of consecutive words of str is
+// separated by a delimiter, by a change from a lowercase letter or digit to an
+// uppercase letter, or follows an acronym of at least two letters (e.g.
+// "XMLHttp").
+//
+// If words can not be located within str, separated returns true.
+func separated(str string, words []string) bool {
+	rs := []rune(str)
+	i := 0
+	prevStart, prevEnd := -1, -1
+	for _, word := range words {
+		start := -1
+		for _, wr := range word {
+			for i < len(rs) && foldRune(rs[i]) != foldRune(wr) {
+				if unicode.IsLetter(rs[i]) || unicode.IsNumber(rs[i]) {
+					return true
+				}
+				i++
+			}
+			if i == len(rs) {
+				return true
+			}
+			if start < 0 {
+				start = i
+			}
+			i++
+		}
+		if start < 0 {
+			continue
+		}
+		if prevEnd >= 0 && start == prevEnd+1 {
+			a, b := rs[prevEnd], rs[start]
+			switch {
+			case unicode.IsUpper(b) && !unicode.IsUpper(a) && (unicode.IsLetter(a) || unicode.IsNumber(a)):
+			case unicode.IsUpper(b) && unicode.IsUpper(a) && prevEnd > prevStart && unicode.IsUpper(rs[prevEnd-1]):
+			default:
+				return false
+			}
+		}
+		prevStart, prevEnd = start, i-1
+	}
+	return true
+}
+
+// ConverterE is a Converter which is able to report errors.
+type ConverterE interface {
+	Converter
+	ConvertE(req ConvertRequest) (string, error)
+}
+
+// StrictConverter is a ConverterE which reports conversions which lose
+// information.
+//
+// The naming Convention of the input is detected with DetectConvention. The
+// output is converted back to that Convention using the same request options
+// and compared with the input. If they differ, or if the output is not made of
+// the same words as the input (see RoundTrips), a *LossError is returned. If
+// the Convention of the input can not be detected, ErrUnknownConvention is
+// returned.
+//
+// The ReplaceStyle of a request which is not camel case does not apply when
+// converting back to a camel Convention; ReplaceStyleScreaming is used
+// instead.
+type StrictConverter struct {
+	Converter Converter
+}
+
+// NewStrictConverter creates a new StrictConverter which uses converter, or
+// DefaultConverter if converter is nil, to perform conversions.
+func NewStrictConverter(converter Converter) StrictConverter {
+	if converter == nil {
+		converter = DefaultConverter
+	}
+	return StrictConverter{Converter: converter}
+}
+
+// Convert converts the input without checking for loss of information.
+func (sc StrictConverter) Convert(req ConvertRequest) string {
+	return sc.converter().Convert(req)
+}
+
+// ConvertE converts the input, returning an error if information would be
+// lost. The input and output are validated as described by
+// StdConverter.ConvertE.
+func (sc StrictConverter) ConvertE(req ConvertRequest) (string, error) {
+	converter := sc.converter()
+	out, err := convertE(converter, req)
+	if err != nil {
+		return out, err
+	}
+	from := DetectConvention(req.Input)
+	if from == ConventionUnknown {
+		return out, ErrUnknownConvention
+	}
+	// the ReplaceStyle of delimited requests does not apply to camel
+	// conventions
+	replaceStyle := req.ReplaceStyle
+	if !req.Style.IsCamel() && !req.Style.IsLowerCamel() {
+		replaceStyle = ReplaceStyleScreaming
+	}
+	return out, checkLoss(converter, req.Input, out, from, requestConvention(req), replaceStyle, req.AllowedSymbols, req.NumberRules)
+}
+
+// requestConvention returns the Convention produced by req or
+// ConventionUnknown if its Style and Join do not form one.
+func requestConvention(req ConvertRequest) Convention {
+	switch {
+	case req.Style.IsCamel() && len(req.Join) == 0:
+		return ConventionCamel
+	case req.Style.IsLowerCamel() && len(req.Join) == 0:
+		return ConventionLowerCamel
+	case req.Style.IsCamel(), req.Style.IsLowerCamel():
+		return ConventionUnknown
+	}
+	screaming := req.Style.IsScreaming()
+	if !screaming && !req.Style.IsLower() {
+		return ConventionUnknown
+	}
+	switch {
+	case req.Join == "_" && screaming:
+		return ConventionScreamingSnake
+	case req.Join == "_":
+		return ConventionSnake
+	case req.Join == "-" && screaming:
+		return ConventionScreamingKebab
+	case req.Join == "-":
+		return ConventionKebab
+	case req.Join == "." && screaming:
+		return ConventionScreamingDotNotation
+	case req.Join == ".":
+		return ConventionDotNotation
+	}
+	return ConventionUnknown
+}
+
+func (sc StrictConverter) converter() Converter {
+	if sc.Converter == nil {
+		return DefaultConverter
+	}
+	return sc.Converter
+}
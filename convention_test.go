@@ -0,0 +1,147 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chanced/caps"
+)
+
+func TestDetectConvention(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected caps.Convention
+	}{
+		{"UserID", caps.ConventionCamel},
+		{"userID", caps.ConventionLowerCamel},
+		{"user", caps.ConventionLowerCamel},
+		{"ID", caps.ConventionScreamingSnake},
+		{"user_id", caps.ConventionSnake},
+		{"USER_ID", caps.ConventionScreamingSnake},
+		{"user-id", caps.ConventionKebab},
+		{"USER-ID", caps.ConventionScreamingKebab},
+		{"user.id", caps.ConventionDotNotation},
+		{"USER.ID", caps.ConventionScreamingDotNotation},
+		{"user_id_v2", caps.ConventionSnake},
+		{"Über", caps.ConventionCamel},
+		{"User_id", caps.ConventionUnknown},
+		{"user_id-v2", caps.ConventionUnknown},
+		{"user id", caps.ConventionUnknown},
+		{"123", caps.ConventionUnknown},
+		{"", caps.ConventionUnknown},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := caps.DetectConvention(test.input); got != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestRoundTrips(t *testing.T) {
+	tests := []struct {
+		input    string
+		from     caps.Convention
+		to       caps.Convention
+		expected bool
+	}{
+		{"UserIDs", caps.ConventionCamel, caps.ConventionSnake, false},
+		{"Sha256", caps.ConventionCamel, caps.ConventionSnake, false},
+		{"userID", caps.ConventionLowerCamel, caps.ConventionKebab, true},
+		{"user_id_v2", caps.ConventionSnake, caps.ConventionCamel, true},
+		{"userId", caps.ConventionLowerCamel, caps.ConventionSnake, false},
+		{"XMLHttpRequest", caps.ConventionCamel, caps.ConventionSnake, false},
+		{"userID", caps.ConventionUnknown, caps.ConventionSnake, false},
+	}
+	c := caps.New()
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := caps.RoundTrips(test.input, test.from, test.to); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+			if got := c.RoundTrips(test.input, test.from, test.to); got != test.expected {
+				t.Errorf("expected Caps.RoundTrips to return %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestToConventionStrict(t *testing.T) {
+	got, err := caps.ToConventionStrict("getHTTPResponseCode", caps.ConventionSnake)
+	if err != nil {
+		t.Error(err)
+	}
+	if got != "get_http_response_code" {
+		t.Errorf("expected %q, got %q", "get_http_response_code", got)
+	}
+
+	got, err = caps.ToConventionStrict("userId", caps.ConventionSnake)
+	var lossErr *caps.LossError
+	if !errors.As(err, &lossErr) {
+		t.Fatalf("expected a *LossError, got %v", err)
+	}
+	if got != "user_id" || lossErr.Output != "user_id" || lossErr.RoundTrip != "userID" {
+		t.Errorf("unexpected result %q and error %+v", got, lossErr)
+	}
+	if lossErr.From != caps.ConventionLowerCamel || lossErr.To != caps.ConventionSnake {
+		t.Errorf("expected conversion from %s to %s, got %s to %s", caps.ConventionLowerCamel, caps.ConventionSnake, lossErr.From, lossErr.To)
+	}
+
+	if _, err = caps.ToConventionStrict("user id", caps.ConventionSnake); !errors.Is(err, caps.ErrUnknownConvention) {
+		t.Errorf("expected ErrUnknownConvention, got %v", err)
+	}
+
+	var converter caps.ConverterE = caps.NewStrictConverter(nil)
+	req := caps.ConvertRequest{Style: caps.StyleLower, ReplaceStyle: caps.ReplaceStyleLower, Join: "_", Input: "userId"}
+	if _, err := converter.ConvertE(req); !errors.As(err, &lossErr) {
+		t.Errorf("expected a *LossError, got %v", err)
+	}
+	req.Input = "userID"
+	if got, err := converter.ConvertE(req); err != nil || got != "user_id" {
+		t.Errorf("expected %q, got %q and error %v", "user_id", got, err)
+	}
+	req.Input = "Id"
+	if _, err := converter.ConvertE(req); !errors.As(err, &lossErr) {
+		t.Errorf("expected a *LossError, got %v", err)
+	} else if lossErr.To != caps.ConventionSnake {
+		t.Errorf("expected conversion to %s, got %s", caps.ConventionSnake, lossErr.To)
+	}
+	req.Input = "UserIDs"
+	if got, err := converter.ConvertE(req); !errors.As(err, &lossErr) {
+		t.Errorf("expected a *LossError, got %q and error %v", got, err)
+	} else if lossErr.RoundTrip != "UserIDs" {
+		t.Errorf("expected round trip %q, got %q", "UserIDs", lossErr.RoundTrip)
+	}
+
+	title := caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Join: " ", Input: "userId"}
+	if got, err := converter.ConvertE(title); !errors.As(err, &lossErr) {
+		t.Errorf("expected a *LossError, got %q and error %v", got, err)
+	} else if lossErr.To != caps.ConventionUnknown {
+		t.Errorf("expected conversion to %s, got %s", caps.ConventionUnknown, lossErr.To)
+	}
+}
@@ -27,6 +27,10 @@ package caps
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"github.com/chanced/caps/token"
+	"github.com/chanced/caps/translit"
 )
 
 // UpperFirst converts the first rune of str to unicode upper case.
@@ -166,17 +170,37 @@ func ToScreamingDotNotation[T ~string](str T, options ...Opts) T {
 // ToTitle transforms the case of str into Title Case (e.g. An Example String) using
 // either the provided Converter or the DefaultConverter otherwise.
 //
+// If opts.TitleStyle is set, small words (e.g. articles and prepositions)
+// are lowercased according to the style guide.
+//
 //	caps.ToTitle("This is [an] {example}${id32}.") // This Is An Example ID 32
+//	caps.ToTitle("the lord of the rings", caps.WithTitleStyle(caps.TitleStyleChicago)) // The Lord of the Rings
 func ToTitle[T ~string](str T, options ...Opts) T {
 	opts := loadOpts(options)
-	return T(opts.Converter.Convert(ConvertRequest{
+	return T(applyTitleStyle(token.DefaultCaser, opts.TitleStyle, opts.Converter.Convert(ConvertRequest{
 		Style:          StyleCamel,
 		ReplaceStyle:   opts.ReplaceStyle,
 		Input:          string(str),
 		Join:           " ",
-		AllowedSymbols: opts.AllowedSymbols,
+		AllowedSymbols: titleSymbols(opts.TitleStyle, opts.AllowedSymbols),
 		NumberRules:    opts.NumberRules,
-	}))
+	})))
+}
+
+// ToSentence transforms the case of str into Sentence case (e.g. An example
+// string) using either the provided Converter or the DefaultConverter
+// otherwise.
+//
+// Only the first word is capitalized. Replacements are written in their
+// screaming form (e.g. "ID"), unless the ReplaceStyle specifies otherwise, and
+// words matching opts.ProperNouns are written as they appear in ProperNouns.
+//
+//	caps.ToSentence("userIDMustBeSet") // User ID must be set
+//	caps.ToSentence("http_request_url_is_invalid") // HTTP request URL is invalid
+//	caps.ToSentence("visit_new_york", caps.WithProperNouns("New York")) // Visit New York
+func ToSentence[T ~string](str T, options ...Opts) T {
+	opts := loadOpts(options)
+	return T(toSentence(opts.Converter, token.DefaultCaser, string(str), opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules, opts.ProperNouns))
 }
 
 // ToDelimited transforms the case of str into a string separated by delimiter,
@@ -212,6 +236,80 @@ func ToDelimited[T ~string](str T, delimiter string, lowercase bool, options ...
 	}))
 }
 
+// ToSlug transforms str into an ASCII slug suitable for URLs and identifiers
+// (e.g. an-example-string) using either the provided Converter or the
+// DefaultConverter otherwise.
+//
+// Diacritics are folded and common Latin, Cyrillic and Greek letters are
+// transliterated (see the translit package). Runes which can not be
+// transliterated are removed. Symbols which are not in AllowedSymbols separate
+// words, with the exception of apostrophes which are removed.
+//
+// The output is lowercase and delimited by "-" unless opts specify otherwise.
+// If MaxLen is set, the slug is cut at a word boundary.
+//
+//	caps.ToSlug("Crème Brûlée Café") // creme-brulee-cafe
+//	caps.ToSlug("Łódź") // lodz
+//	caps.ToSlug("Don't stop/believing", caps.SlugOpts{MaxLen: 10}) // dont-stop
+//	caps.ToSlug("Crème Brûlée", caps.SlugOpts{Style: caps.StyleScreaming, Delimiter: "_"}) // CREME_BRULEE
+func ToSlug[T ~string](str T, options ...SlugOpts) T {
+	opts := loadSlugOpts(options)
+	replaceStyle := opts.ReplaceStyle
+	switch opts.Style {
+	case StyleLower:
+		replaceStyle = ReplaceStyleLower
+	case StyleScreaming:
+		replaceStyle = ReplaceStyleScreaming
+	}
+	res := opts.Converter.Convert(ConvertRequest{
+		Style:          opts.Style,
+		ReplaceStyle:   replaceStyle,
+		Input:          slugWords(translit.ToASCII(string(str)), opts.AllowedSymbols, opts.NumberRules),
+		Join:           opts.Delimiter,
+		AllowedSymbols: opts.AllowedSymbols,
+		NumberRules:    opts.NumberRules,
+	})
+	return T(truncateWords(res, opts.Delimiter, opts.MaxLen))
+}
+
+// slugWords replaces each rune of s which is not a letter, number, allowed
+// symbol or number rule with a space and removes apostrophes.
+func slugWords(s string, allowed string, numberRules NumberRules) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			return r
+		case r == '\'' || r == '’':
+			return -1
+		case strings.ContainsRune(allowed, r):
+			return r
+		}
+		if _, ok := numberRules[r]; ok {
+			return r
+		}
+		return ' '
+	}, s)
+}
+
+// truncateWords cuts s, a string of words joined by delimiter, to at most
+// maxLen bytes at the last delimiter which fits. If the first word does not
+// fit, it is truncated.
+func truncateWords(s string, delimiter string, maxLen int) string {
+	if maxLen <= 0 || len(s) <= maxLen {
+		return s
+	}
+	if strings.HasPrefix(s[maxLen:], delimiter) {
+		return s[:maxLen]
+	}
+	if i := strings.LastIndex(s[:maxLen], delimiter); i > 0 {
+		return s[:i]
+	}
+	for maxLen > 0 && !utf8.RuneStart(s[maxLen]) {
+		maxLen--
+	}
+	return s[:maxLen]
+}
+
 // ToLower returns s with all Unicode letters mapped to their lower case.
 func ToLower[T ~string](str T) T {
 	return T(strings.ToLower((string(str))))
@@ -221,3 +319,86 @@ func ToLower[T ~string](str T) T {
 func ToUpper[T ~string](str T) T {
 	return T(strings.ToUpper((string(str))))
 }
+
+// TryToCamel is like ToCamel but reports invalid input and output, as
+// described by StdConverter.ConvertE, and enforces opts.MaxLen.
+//
+//	caps.TryToCamel("user_id") // UserID, nil
+//	caps.TryToCamel("2fa_code") // 2FaCode, *LeadingDigitError
+func TryToCamel[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionCamel, options)
+}
+
+// TryToLowerCamel is like ToLowerCamel but reports invalid input and output,
+// as described by StdConverter.ConvertE, and enforces opts.MaxLen.
+func TryToLowerCamel[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionLowerCamel, options)
+}
+
+// TryToSnake is like ToSnake but reports invalid input and output, as
+// described by StdConverter.ConvertE, and enforces opts.MaxLen.
+//
+//	caps.TryToSnake("aVeryLongName", caps.WithMaxLen(8)) // a_very_long_name, *MaxLenError
+func TryToSnake[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionSnake, options)
+}
+
+// TryToScreamingSnake is like ToScreamingSnake but reports invalid input and
+// output, as described by StdConverter.ConvertE, and enforces opts.MaxLen.
+func TryToScreamingSnake[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionScreamingSnake, options)
+}
+
+// TryToKebab is like ToKebab but reports invalid input and output, as
+// described by StdConverter.ConvertE, and enforces opts.MaxLen.
+func TryToKebab[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionKebab, options)
+}
+
+// TryToScreamingKebab is like ToScreamingKebab but reports invalid input and
+// output, as described by StdConverter.ConvertE, and enforces opts.MaxLen.
+func TryToScreamingKebab[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionScreamingKebab, options)
+}
+
+// TryToDotNotation is like ToDotNotation but reports invalid input and
+// output, as described by StdConverter.ConvertE, and enforces opts.MaxLen.
+func TryToDotNotation[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionDotNotation, options)
+}
+
+// TryToScreamingDotNotation is like ToScreamingDotNotation but reports
+// invalid input and output, as described by StdConverter.ConvertE, and
+// enforces opts.MaxLen.
+func TryToScreamingDotNotation[T ~string](str T, options ...Opts) (T, error) {
+	return tryToConvention(str, ConventionScreamingDotNotation, options)
+}
+
+// TryToDelimited is like ToDelimited but reports invalid input and output, as
+// described by StdConverter.ConvertE, and enforces opts.MaxLen.
+func TryToDelimited[T ~string](str T, delimiter string, lowercase bool, options ...Opts) (T, error) {
+	opts := loadOpts(options)
+	req := ConvertRequest{
+		Style:          StyleScreaming,
+		ReplaceStyle:   ReplaceStyleScreaming,
+		Input:          string(str),
+		Join:           delimiter,
+		AllowedSymbols: opts.AllowedSymbols,
+		NumberRules:    opts.NumberRules,
+		MaxLen:         opts.MaxLen,
+	}
+	if lowercase {
+		req.Style = StyleLower
+		req.ReplaceStyle = ReplaceStyleLower
+	}
+	res, err := convertE(opts.Converter, req)
+	return T(res), err
+}
+
+func tryToConvention[T ~string](str T, c Convention, options []Opts) (T, error) {
+	opts := loadOpts(options)
+	req := c.request(string(str), opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules)
+	req.MaxLen = opts.MaxLen
+	res, err := convertE(opts.Converter, req)
+	return T(res), err
+}
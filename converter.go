@@ -28,6 +28,7 @@ import (
 	"strings"
 	"sync"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/chanced/caps/index"
 	"github.com/chanced/caps/token"
@@ -75,6 +76,64 @@ type ConvertRequest struct {
 	Join           string
 	AllowedSymbols string
 	NumberRules    map[rune]func(index int, r rune, val string) bool
+	// MaxLen is the maximum length, in bytes, of the output enforced by
+	// ConvertE. Convert does not enforce MaxLen.
+	//
+	// If MaxLen is 0, the length of the output is not limited.
+	MaxLen int
+}
+
+// ConverterOpts include configurable options for a StdConverter.
+//
+// See the documentation for the individual fields for more information.
+type ConverterOpts struct {
+	// SplitEmbedded enables splitting tokens around replacements which are
+	// embedded within them. For example, "getuserhttpclient" is a single
+	// token which contains the replacement "http", resulting in
+	// "GetuserHTTPClient" when converted to camel case.
+	//
+	// When replacements overlap, the leftmost-longest matches are used.
+	//
+	// Default: false
+	SplitEmbedded bool
+	// MinEmbeddedLen is the minimum length, in runes, of replacements which
+	// are split from tokens when SplitEmbedded is set. Short replacements
+	// (e.g. "id") would otherwise be found within unrelated words (e.g.
+	// "video").
+	//
+	// Default: index.DefaultScannerMinLen (4)
+	MinEmbeddedLen int
+	// SpellNumbers spells out numbers as words before the input is tokenized
+	// (e.g. "3d_model" becomes "ThreeDModel" when converted to camel case).
+	// See SpellNumbers for more information.
+	//
+	// Default: SpellModeNotSpecified (numbers are not spelled out)
+	SpellNumbers SpellMode
+	// Speller spells out numbers if SpellNumbers is set.
+	//
+	// Default: DefaultSpeller
+	Speller Speller
+}
+
+func loadConverterOpts(opts []ConverterOpts) ConverterOpts {
+	result := ConverterOpts{
+		MinEmbeddedLen: index.DefaultScannerMinLen,
+	}
+	for _, opt := range opts {
+		if opt.SplitEmbedded {
+			result.SplitEmbedded = true
+		}
+		if opt.MinEmbeddedLen > 0 {
+			result.MinEmbeddedLen = opt.MinEmbeddedLen
+		}
+		if opt.SpellNumbers != SpellModeNotSpecified {
+			result.SpellNumbers = opt.SpellNumbers
+		}
+		if opt.Speller != nil {
+			result.Speller = opt.Speller
+		}
+	}
+	return result
 }
 
 // NewConverter creates a new Converter which is used to convert the input text to the desired output.
@@ -83,15 +142,36 @@ type ConvertRequest struct {
 // formatting (e.g. { "Json", "JSON"}).
 //
 // tokenizer is used to tokenize the input text.
-func NewConverter(replacements []Replacement, tokenizer Tokenizer, caser token.Caser) StdConverter {
+func NewConverter(replacements []Replacement, tokenizer Tokenizer, caser token.Caser, options ...ConverterOpts) StdConverter {
 	sc := StdConverter{
 		index:     index.New(caser),
 		tokenizer: tokenizer,
 		caser:     token.CaserOrDefault(caser),
+		opts:      loadConverterOpts(options),
 	}
 	for _, v := range replacements {
 		sc.set(v.Camel, v.Screaming)
 	}
+	sc.scan()
+	return sc
+}
+
+// NewConverterFromFrozen creates a new StdConverter which uses the
+// replacements of frozen, typically decoded with index.Frozen.UnmarshalBinary.
+//
+// Replacements are looked up in frozen directly (see index.Frozen.Index)
+// rather than in a trie, making this considerably faster than NewConverter
+// for large sets of replacements. The trie is only built if the replacements
+// are changed with Set or Delete. frozen must not be modified while the
+// StdConverter is in use.
+func NewConverterFromFrozen(frozen *index.Frozen, tokenizer Tokenizer, caser token.Caser, options ...ConverterOpts) StdConverter {
+	sc := StdConverter{
+		index:     frozen.Index(),
+		tokenizer: tokenizer,
+		caser:     token.CaserOrDefault(caser),
+		opts:      loadConverterOpts(options),
+	}
+	sc.scan()
 	return sc
 }
 
@@ -107,12 +187,115 @@ type StdConverter struct {
 	index     *index.Index
 	tokenizer Tokenizer
 	caser     token.Caser
+	opts      ConverterOpts
+	// scanner is set if opts.SplitEmbedded is true. Like index, it is shared
+	// by copies of the StdConverter.
+	scanner *lazyScanner
+}
+
+// lazyScanner builds the Scanner of an index on first use and again after it
+// is reset by a change to the index, so that loading a table with Set does not
+// rebuild the Scanner for every Replacement.
+type lazyScanner struct {
+	mu      sync.RWMutex
+	scanner *index.Scanner
+}
+
+// get returns the Scanner of idx, building it if the index has changed since
+// it was last built.
+func (ls *lazyScanner) get(idx *index.Index, minLen int) *index.Scanner {
+	ls.mu.RLock()
+	s := ls.scanner
+	ls.mu.RUnlock()
+	if s != nil {
+		return s
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if ls.scanner == nil {
+		ls.scanner = index.NewScanner(idx, minLen)
+	}
+	return ls.scanner
+}
+
+// reset discards the Scanner so that it is rebuilt on next use.
+func (ls *lazyScanner) reset() {
+	ls.mu.Lock()
+	ls.scanner = nil
+	ls.mu.Unlock()
+}
+
+// clone returns a copy of sc with its own index of replacements.
+func (sc StdConverter) clone() StdConverter {
+	sc.index = sc.index.Copy()
+	sc.scan()
+	return sc
+}
+
+// scan gives sc a new Scanner, built on first use, if SplitEmbedded is
+// enabled.
+func (sc *StdConverter) scan() {
+	if sc.opts.SplitEmbedded {
+		sc.scanner = &lazyScanner{}
+	}
+}
+
+// changed resets the Scanner of sc, which is shared by its copies, after the
+// index is modified.
+func (sc *StdConverter) changed() {
+	if sc.scanner != nil {
+		sc.scanner.reset()
+	}
+}
+
+// splitEmbedded splits each token of tokens which contains, but is not
+// itself, a replacement around the leftmost-longest replacements it contains.
+func (sc StdConverter) splitEmbedded(tokens []string) []string {
+	var res []string
+	scanner := sc.scanner.get(sc.index, sc.opts.MinEmbeddedLen)
+	for i, tok := range tokens {
+		var occurrences []index.Occurrence
+		if utf8.RuneCountInString(tok) > sc.opts.MinEmbeddedLen && !sc.index.Contains(tok) {
+			occurrences = scanner.FindLongest(tok)
+		}
+		if len(occurrences) == 0 {
+			if res != nil {
+				res = append(res, tok)
+			}
+			continue
+		}
+		if res == nil {
+			res = make([]string, i, len(tokens)+2*len(occurrences))
+			copy(res, tokens[:i])
+		}
+		prev := 0
+		for _, o := range occurrences {
+			if o.Start > prev {
+				res = append(res, tok[prev:o.Start])
+			}
+			res = append(res, tok[o.Start:o.End])
+			prev = o.End
+		}
+		if prev < len(tok) {
+			res = append(res, tok[prev:])
+		}
+	}
+	if res == nil {
+		return tokens
+	}
+	return res
 }
 
 func (sc StdConverter) Index() index.Index {
 	return *sc.index
 }
 
+// Freeze returns an immutable copy of the replacement table of sc which can be
+// encoded with MarshalBinary.
+func (sc StdConverter) Freeze() *index.Frozen {
+	return sc.index.Freeze()
+}
+
 // Contains reports whether a key is in the Converter's replacement table.
 func (sc StdConverter) Contains(key string) bool {
 	return sc.index.Contains(key)
@@ -137,22 +320,33 @@ func (sc *StdConverter) set(key, value string) {
 
 // Set adds the key/value pair to the table.
 func (sc *StdConverter) Set(key, value string) {
-	kstr, keyHasLower := lowerAndCheck(key)
-	vstr, valueHasLower := lowerAndCheck(value)
-	sc.Delete(kstr)
-	sc.Delete(vstr)
+	sc.index.Delete(key)
+	sc.index.Delete(value)
 
 	// checking to see if we need to swap these.
-	if !keyHasLower && valueHasLower {
+	if !hasLower(key) && hasLower(value) {
 		sc.set(value, key)
 	} else {
 		sc.set(key, value)
 	}
+	sc.changed()
+}
+
+// ConvertE is like Convert but reports invalid input and output.
+//
+// An *InvalidUTF8Error is returned if req.Input is not valid UTF-8. An
+// *EmptyOutputError is returned if a non-empty input results in an empty
+// output, a *LeadingDigitError if the output begins with a digit and a
+// *MaxLenError if req.MaxLen is set and exceeded. With the exception of
+// *InvalidUTF8Error, the output is returned along with the error.
+func (sc StdConverter) ConvertE(req ConvertRequest) (string, error) {
+	return checkConversion(req, sc.Convert)
 }
 
 // Remove deletes the key from the map. Either variant is sufficient.
 func (sc *StdConverter) Delete(key string) {
 	sc.index.Delete(key)
+	sc.changed()
 }
 
 func (StdConverter) writeIndexReplacement(b *strings.Builder, style Style, repStyle ReplaceStyle, join string, rep index.IndexedReplacement) {
@@ -183,14 +377,23 @@ func (sc StdConverter) writeToken(b *strings.Builder, style Style, join string,
 	if len(join) > 0 && b.Len() > 0 {
 		b.WriteString(join)
 	}
+	graphemes := sc.graphemes()
+	if _, ok := sc.caser.(token.Unicode); ok && !graphemes && isASCII(tok) {
+		writeTokenASCII(b, style, tok)
+		return
+	}
+	upperFirst := token.WriteUpperFirstLowerRest
+	if graphemes {
+		upperFirst = token.WriteUpperFirstGraphemeLowerRest
+	}
 	switch style {
 	case StyleCamel:
-		token.WriteUpperFirstLowerRest(b, sc.caser, tok)
+		upperFirst(b, sc.caser, tok)
 	case StyleLowerCamel:
 		if b.Len() == 0 {
 			token.WriteLower(b, sc.caser, tok)
 		} else {
-			token.WriteUpperFirstLowerRest(b, sc.caser, tok)
+			upperFirst(b, sc.caser, tok)
 		}
 	case StyleScreaming:
 		token.WriteUpper(b, sc.caser, tok)
@@ -201,6 +404,40 @@ func (sc StdConverter) writeToken(b *strings.Builder, style Style, join string,
 	}
 }
 
+// graphemes reports whether the tokenizer of sc segments input by extended
+// grapheme clusters, in which case the first letter of the first cluster of a
+// token is cased rather than the first rune.
+func (sc StdConverter) graphemes() bool {
+	t, ok := sc.tokenizer.(interface{ Graphemes() bool })
+	return ok && t.Graphemes()
+}
+
+// writeTokenASCII is the equivalent of writeToken for ASCII tokens when the
+// Caser is token.Unicode, which cases ASCII letters as strings.ToUpper and
+// strings.ToLower do.
+func writeTokenASCII(b *strings.Builder, style Style, tok string) {
+	if style == StyleLowerCamel && b.Len() == 0 {
+		style = StyleLower
+	}
+	switch style {
+	case StyleCamel, StyleLowerCamel:
+		b.WriteByte(toUpperASCII(tok[0]))
+		for i := 1; i < len(tok); i++ {
+			b.WriteByte(toLowerASCII(tok[i]))
+		}
+	case StyleScreaming:
+		for i := 0; i < len(tok); i++ {
+			b.WriteByte(toUpperASCII(tok[i]))
+		}
+	case StyleLower:
+		for i := 0; i < len(tok); i++ {
+			b.WriteByte(toLowerASCII(tok[i]))
+		}
+	default:
+		b.WriteString(tok)
+	}
+}
+
 func (sc StdConverter) writeReplaceSplit(b *strings.Builder, style Style, join string, s []rune) {
 	switch style {
 	case StyleCamel:
@@ -222,10 +459,30 @@ func (sc StdConverter) writeReplaceSplit(b *strings.Builder, style Style, join s
 
 // Convert formats the string with the desired style.
 func (sc StdConverter) Convert(req ConvertRequest) string {
-	tokens := sc.tokenizer.Tokenize(req.Input, req.AllowedSymbols, req.NumberRules)
+	req.Input = sc.spell(req.Input)
+	return sc.convert(req, sc.tokenizer.Tokenize(req.Input, req.AllowedSymbols, req.NumberRules))
+}
+
+// spell spells out the numbers of s if opts.SpellNumbers is set.
+func (sc StdConverter) spell(s string) string {
+	if sc.opts.SpellNumbers == SpellModeNotSpecified {
+		return s
+	}
+	speller := sc.opts.Speller
+	if speller == nil {
+		speller = DefaultSpeller
+	}
+	return spellNumbers(s, sc.opts.SpellNumbers, speller)
+}
+
+// convert formats tokens, the tokens of req.Input, according to req.
+func (sc StdConverter) convert(req ConvertRequest, tokens []string) string {
 	if len(tokens) == 0 {
 		return ""
 	}
+	if sc.scanner != nil {
+		tokens = sc.splitEmbedded(tokens)
+	}
 	b := builderPool.Get().(*strings.Builder)
 	b.Reset()
 	defer builderPool.Put(b)
@@ -324,19 +581,14 @@ func isNextTokenNumber(tokens []string, i int) bool {
 	return false
 }
 
-func lowerAndCheck(input string) (string, bool) {
-	bldr := builderPool.Get().(*strings.Builder)
-	bldr.Reset()
-	defer builderPool.Put(bldr)
-	bldr.Grow(len(input))
-	foundLower := false
-	for _, r := range input {
-		if !foundLower && unicode.IsLower(r) {
-			foundLower = true
+// hasLower reports whether s contains a lowercase letter.
+func hasLower(s string) bool {
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return true
 		}
-		bldr.WriteRune(r)
 	}
-	return bldr.String(), foundLower
+	return false
 }
 
 // Deprecated: Use StdConverter
@@ -28,6 +28,7 @@ import (
 	"testing"
 
 	"github.com/chanced/caps"
+	"github.com/chanced/caps/index"
 	"github.com/chanced/caps/token"
 )
 
@@ -164,3 +165,115 @@ func TestConverterReplacements(t *testing.T) {
 		t.Errorf("expected ID, got %s", r[0].Screaming)
 	}
 }
+
+func TestConverterFrozen(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, nil)
+	data, err := converter.Freeze().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	frozen := index.NewFrozen(nil)
+	if err := frozen.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	loaded := caps.NewConverterFromFrozen(frozen, caps.DefaultTokenizer, nil)
+	if len(loaded.Replacements()) != len(converter.Replacements()) {
+		t.Errorf("expected %d replacements, got %d", len(converter.Replacements()), len(loaded.Replacements()))
+	}
+	for _, input := range []string{"marshalJson", "http_request_id", "uuid utf8 html", "getHTTPServerID", "H_T_T_P_S", "ipv4_addr", "X_M_L_HTTP"} {
+		req := caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Input: input}
+		if expected, got := converter.Convert(req), loaded.Convert(req); expected != got {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	}
+
+	loaded.Set("Foo", "FOO")
+	req := caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Input: "foo_id"}
+	if got := loaded.Convert(req); got != "FOOID" {
+		t.Errorf("expected %q, got %q", "FOOID", got)
+	}
+	if !frozen.Contains("Json") || frozen.Contains("Foo") {
+		t.Error("expected the frozen index to be unchanged")
+	}
+}
+
+func TestConverterSplitEmbedded(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, nil, caps.ConverterOpts{SplitEmbedded: true})
+	tests := []struct {
+		input    string
+		style    caps.Style
+		expected string
+	}{
+		{"getuserhttpclient", caps.StyleCamel, "GetuserHTTPClient"},
+		{"getuserhttpclient", caps.StyleLowerCamel, "getuserHTTPClient"},
+		{"parsejsonbody", caps.StyleLowerCamel, "parseJSONBody"},
+		{"video", caps.StyleCamel, "Video"},
+		{"http", caps.StyleCamel, "HTTP"},
+		{"https_server", caps.StyleCamel, "HTTPSServer"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			req := caps.ConvertRequest{Style: test.style, ReplaceStyle: caps.ReplaceStyleScreaming, Input: test.input}
+			if got := converter.Convert(req); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+
+	// c holds a copy of converter, which shares its replacements
+	c := caps.New(caps.Config{Converter: converter})
+	converter.Set("Grpc", "GRPC")
+	req := caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Input: "newgrpcclient"}
+	if got := converter.Convert(req); got != "NewGRPCClient" {
+		t.Errorf("expected %q, got %q", "NewGRPCClient", got)
+	}
+	if got := c.ToCamel("newgrpcclient"); got != "NewGRPCClient" {
+		t.Errorf("expected copy to give %q, got %q", "NewGRPCClient", got)
+	}
+	converter.Delete("Grpc")
+	if got := converter.Convert(req); got != "Newgrpcclient" {
+		t.Errorf("expected %q, got %q", "Newgrpcclient", got)
+	}
+	if got := c.ToCamel("newgrpcclient"); got != "Newgrpcclient" {
+		t.Errorf("expected copy to give %q, got %q", "Newgrpcclient", got)
+	}
+
+	short := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, nil, caps.ConverterOpts{SplitEmbedded: true, MinEmbeddedLen: 2})
+	req = caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Input: "userid"}
+	if got := short.Convert(req); got != "UserID" {
+		t.Errorf("expected %q, got %q", "UserID", got)
+	}
+}
+
+func TestConverterSetMultiByte(t *testing.T) {
+	converter := caps.NewConverter(nil, caps.DefaultTokenizer, nil)
+	converter.Set("ÜBER", "Über")
+	converter.Set("Смс", "СМС")
+	converter.Set("Смс", "СМСК")
+	req := caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Input: "über смс смск"}
+	if got, expected := converter.Convert(req), "ÜBERСМСКСМСК"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	converter.Delete("über")
+	if n := len(converter.Replacements()); n != 1 {
+		t.Errorf("expected 1 replacement, got %d", n)
+	}
+}
+
+// unicodeCaser is token.Unicode under a different type, which disables the
+// ASCII fast path of StdConverter.
+type unicodeCaser struct{ token.Unicode }
+
+func TestConverterASCII(t *testing.T) {
+	fast := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, nil)
+	slow := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, unicodeCaser{})
+	styles := []caps.Style{caps.StyleCamel, caps.StyleLowerCamel, caps.StyleScreaming, caps.StyleLower}
+	for _, input := range asciiTokenizerInputs {
+		for _, style := range styles {
+			req := caps.ConvertRequest{Style: style, ReplaceStyle: caps.ReplaceStyleScreaming, Input: input, Join: "_"}
+			if expected, got := slow.Convert(req), fast.Convert(req); expected != got {
+				t.Errorf("Convert(%q, %s): expected %q, got %q", input, style, expected, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// InvalidUTF8Error is returned by ConvertE when the input is not valid UTF-8.
+type InvalidUTF8Error struct {
+	Input string
+	// Offset is the byte offset of the first invalid byte of Input.
+	Offset int
+}
+
+func (e *InvalidUTF8Error) Error() string {
+	return fmt.Sprintf("caps: input %q contains invalid UTF-8 at byte %d", e.Input, e.Offset)
+}
+
+// EmptyOutputError is returned by ConvertE when the conversion of a non-empty
+// input results in an empty string, such as when the input consists solely
+// of symbols which are not allowed.
+type EmptyOutputError struct {
+	Input string
+}
+
+func (e *EmptyOutputError) Error() string {
+	return fmt.Sprintf("caps: converting %q results in an empty string", e.Input)
+}
+
+// LeadingDigitError is returned by ConvertE when the output begins with a
+// digit, which most programming languages do not permit for identifiers.
+type LeadingDigitError struct {
+	Input  string
+	Output string
+}
+
+func (e *LeadingDigitError) Error() string {
+	return fmt.Sprintf("caps: converting %q results in %q, which begins with a digit", e.Input, e.Output)
+}
+
+// MaxLenError is returned by ConvertE when the length of the output, in bytes,
+// exceeds the MaxLen of the ConvertRequest.
+type MaxLenError struct {
+	Input  string
+	Output string
+	MaxLen int
+}
+
+func (e *MaxLenError) Error() string {
+	return fmt.Sprintf("caps: converting %q results in %q, which exceeds the maximum length of %d bytes by %d", e.Input, e.Output, e.MaxLen, len(e.Output)-e.MaxLen)
+}
+
+// convertE converts req with converter, using ConvertE if converter is a
+// ConverterE.
+func convertE(converter Converter, req ConvertRequest) (string, error) {
+	if ce, ok := converter.(ConverterE); ok {
+		return ce.ConvertE(req)
+	}
+	return checkConversion(req, converter.Convert)
+}
+
+// checkConversion validates the input of req, converts it with convert and
+// validates the output.
+//
+// If the output is invalid, it is returned along with the error.
+func checkConversion(req ConvertRequest, convert func(ConvertRequest) string) (string, error) {
+	for i := 0; i < len(req.Input); {
+		r, w := utf8.DecodeRuneInString(req.Input[i:])
+		if r == utf8.RuneError && w == 1 {
+			return "", &InvalidUTF8Error{Input: req.Input, Offset: i}
+		}
+		i += w
+	}
+	out := convert(req)
+	if len(out) == 0 {
+		if len(req.Input) == 0 {
+			return out, nil
+		}
+		return out, &EmptyOutputError{Input: req.Input}
+	}
+	if r, _ := utf8.DecodeRuneInString(out); unicode.IsDigit(r) {
+		return out, &LeadingDigitError{Input: req.Input, Output: out}
+	}
+	if req.MaxLen > 0 && len(out) > req.MaxLen {
+		return out, &MaxLenError{Input: req.Input, Output: out, MaxLen: req.MaxLen}
+	}
+	return out, nil
+}
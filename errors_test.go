@@ -0,0 +1,117 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chanced/caps"
+)
+
+func TestConvertE(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, nil)
+	var invalidUTF8 *caps.InvalidUTF8Error
+	var emptyOutput *caps.EmptyOutputError
+	var leadingDigit *caps.LeadingDigitError
+	var maxLen *caps.MaxLenError
+
+	tests := []struct {
+		name     string
+		input    string
+		maxLen   int
+		expected string
+		target   interface{}
+	}{
+		{"valid", "user_id", 0, "UserID", nil},
+		{"empty input", "", 0, "", nil},
+		{"invalid utf8", "user\xffid", 0, "", &invalidUTF8},
+		{"empty output", "$$$", 0, "", &emptyOutput},
+		{"leading digit", "2fa_code", 0, "2FaCode", &leadingDigit},
+		{"max len", "user_account_id", 10, "UserAccountID", &maxLen},
+		{"within max len", "user_id", 6, "UserID", nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := caps.ConvertRequest{Style: caps.StyleCamel, ReplaceStyle: caps.ReplaceStyleScreaming, Input: test.input, MaxLen: test.maxLen}
+			got, err := converter.ConvertE(req)
+			if got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+			if test.target == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.As(err, test.target) {
+				t.Errorf("expected %T, got %v", test.target, err)
+			}
+		})
+	}
+	if invalidUTF8.Offset != 4 {
+		t.Errorf("expected the invalid UTF-8 to be at byte 4, got %d", invalidUTF8.Offset)
+	}
+	if maxLen.MaxLen != 10 || maxLen.Output != "UserAccountID" {
+		t.Errorf("unexpected MaxLenError %+v", maxLen)
+	}
+}
+
+func TestTryTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(string, ...caps.Opts) (string, error)
+		expected string
+	}{
+		{"TryToCamel", caps.TryToCamel[string], "UserID"},
+		{"TryToLowerCamel", caps.TryToLowerCamel[string], "userID"},
+		{"TryToSnake", caps.TryToSnake[string], "user_id"},
+		{"TryToScreamingSnake", caps.TryToScreamingSnake[string], "USER_ID"},
+		{"TryToKebab", caps.TryToKebab[string], "user-id"},
+		{"TryToScreamingKebab", caps.TryToScreamingKebab[string], "USER-ID"},
+		{"TryToDotNotation", caps.TryToDotNotation[string], "user.id"},
+		{"TryToScreamingDotNotation", caps.TryToScreamingDotNotation[string], "USER.ID"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.fn("userID")
+			if err != nil || got != test.expected {
+				t.Errorf("expected %q, got %q and error %v", test.expected, got, err)
+			}
+			var maxLen *caps.MaxLenError
+			if _, err := test.fn("userID", caps.WithMaxLen(5)); !errors.As(err, &maxLen) {
+				t.Errorf("expected a *MaxLenError, got %v", err)
+			}
+		})
+	}
+	got, err := caps.TryToDelimited("userID", "/", true)
+	if err != nil || got != "user/id" {
+		t.Errorf("expected %q, got %q and error %v", "user/id", got, err)
+	}
+	var leadingDigit *caps.LeadingDigitError
+	if _, err := caps.TryToSnake("3d_model"); !errors.As(err, &leadingDigit) {
+		t.Errorf("expected a *LeadingDigitError, got %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+//go:build ignore
+
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// gen generates tables.go, the Grapheme_Cluster_Break property table used by
+// package grapheme.
+//
+// The property values are derived from the unicode package of the Go
+// toolchain running the generator, along with the Extended_Pictographic
+// ranges of emoji-data.txt (which the unicode package does not expose).
+//
+// Hangul syllables (LV and LVT) are computed arithmetically at runtime and are
+// not included in the table.
+//
+// Usage:
+//
+//	go run gen.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"unicode"
+)
+
+const (
+	propAny = iota
+	propCR
+	propLF
+	propControl
+	propExtend
+	propZWJ
+	propRegionalIndicator
+	propPrepend
+	propSpacingMark
+	propL
+	propV
+	propT
+	propLV
+	propLVT
+	propExtendedPictographic
+)
+
+var propNames = [...]string{
+	propAny:                  "propAny",
+	propCR:                   "propCR",
+	propLF:                   "propLF",
+	propControl:              "propControl",
+	propExtend:               "propExtend",
+	propZWJ:                  "propZWJ",
+	propRegionalIndicator:    "propRegionalIndicator",
+	propPrepend:              "propPrepend",
+	propSpacingMark:          "propSpacingMark",
+	propL:                    "propL",
+	propV:                    "propV",
+	propT:                    "propT",
+	propLV:                   "propLV",
+	propLVT:                  "propLVT",
+	propExtendedPictographic: "propExtendedPictographic",
+}
+
+// extendedPictographic is the Extended_Pictographic property from
+// https://unicode.org/Public/UCD/latest/ucd/emoji/emoji-data.txt
+var extendedPictographic = [][2]rune{
+	{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x203C, 0x203C}, {0x2049, 0x2049},
+	{0x2122, 0x2122}, {0x2139, 0x2139}, {0x2194, 0x2199}, {0x21A9, 0x21AA},
+	{0x231A, 0x231B}, {0x2328, 0x2328}, {0x2388, 0x2388}, {0x23CF, 0x23CF},
+	{0x23E9, 0x23F3}, {0x23F8, 0x23FA}, {0x24C2, 0x24C2}, {0x25AA, 0x25AB},
+	{0x25B6, 0x25B6}, {0x25C0, 0x25C0}, {0x25FB, 0x25FE}, {0x2600, 0x2605},
+	{0x2607, 0x2612}, {0x2614, 0x2685}, {0x2690, 0x2705}, {0x2708, 0x2712},
+	{0x2714, 0x2714}, {0x2716, 0x2716}, {0x271D, 0x271D}, {0x2721, 0x2721},
+	{0x2728, 0x2728}, {0x2733, 0x2734}, {0x2744, 0x2744}, {0x2747, 0x2747},
+	{0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755}, {0x2757, 0x2757},
+	{0x2763, 0x2767}, {0x2795, 0x2797}, {0x27A1, 0x27A1}, {0x27B0, 0x27B0},
+	{0x27BF, 0x27BF}, {0x2934, 0x2935}, {0x2B05, 0x2B07}, {0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x3030, 0x3030}, {0x303D, 0x303D},
+	{0x3297, 0x3297}, {0x3299, 0x3299}, {0x1F000, 0x1F0FF}, {0x1F10D, 0x1F10F},
+	{0x1F12F, 0x1F12F}, {0x1F16C, 0x1F171}, {0x1F17E, 0x1F17F}, {0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A}, {0x1F1AD, 0x1F1E5}, {0x1F201, 0x1F20F}, {0x1F21A, 0x1F21A},
+	{0x1F22F, 0x1F22F}, {0x1F232, 0x1F23A}, {0x1F23C, 0x1F23F}, {0x1F249, 0x1F3FA},
+	{0x1F400, 0x1F53D}, {0x1F546, 0x1F64F}, {0x1F680, 0x1F6FF}, {0x1F774, 0x1F77F},
+	{0x1F7D5, 0x1F7FF}, {0x1F80C, 0x1F80F}, {0x1F848, 0x1F84F}, {0x1F85A, 0x1F85F},
+	{0x1F888, 0x1F88F}, {0x1F8AE, 0x1F8FF}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1FAFF}, {0x1FC00, 0x1FFFD},
+}
+
+// spacingMarkExceptions are General_Category=Spacing_Mark runes which are
+// excluded from Grapheme_Cluster_Break=SpacingMark.
+var spacingMarkExceptions = [][2]rune{
+	{0x102B, 0x102C}, {0x1038, 0x1038}, {0x1062, 0x1064}, {0x1067, 0x106D},
+	{0x1083, 0x1083}, {0x1087, 0x108C}, {0x108F, 0x108F}, {0x109A, 0x109C},
+	{0x1A61, 0x1A61}, {0x1A63, 0x1A64}, {0xAA7B, 0xAA7B}, {0xAA7D, 0xAA7D},
+	{0x11720, 0x11721},
+}
+
+// prepend are the Grapheme_Cluster_Break=Prepend runes which are not
+// Prepended_Concatenation_Mark.
+var prepend = [][2]rune{
+	{0x0D4E, 0x0D4E}, {0x111C2, 0x111C3}, {0x1193F, 0x1193F}, {0x11941, 0x11941},
+	{0x11A3A, 0x11A3A}, {0x11A84, 0x11A89}, {0x11D46, 0x11D46},
+}
+
+func in(r rune, ranges [][2]rune) bool {
+	for _, rng := range ranges {
+		if r >= rng[0] && r <= rng[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func isHangulSyllable(r rune) bool {
+	return r >= 0xAC00 && r <= 0xD7A3
+}
+
+func property(r rune) int {
+	switch {
+	case r == '\r':
+		return propCR
+	case r == '\n':
+		return propLF
+	case r == 0x200D:
+		return propZWJ
+	case unicode.Is(unicode.Regional_Indicator, r):
+		return propRegionalIndicator
+	case unicode.Is(unicode.Prepended_Concatenation_Mark, r), in(r, prepend):
+		return propPrepend
+	case r >= 0xE0020 && r <= 0xE007F, r == 0x200C:
+		return propExtend
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf):
+		return propControl
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend),
+		r >= 0x1F3FB && r <= 0x1F3FF:
+		return propExtend
+	case r == 0x0E33, r == 0x0EB3:
+		return propSpacingMark
+	case unicode.Is(unicode.Mc, r) && !in(r, spacingMarkExceptions):
+		return propSpacingMark
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return propL
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return propV
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return propT
+	case in(r, extendedPictographic):
+		return propExtendedPictographic
+	}
+	return propAny
+}
+
+func main() {
+	type rng struct {
+		lo, hi rune
+		prop   int
+	}
+	var ranges []rng
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if isHangulSyllable(r) {
+			continue
+		}
+		p := property(r)
+		if p == propAny {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].prop == p && ranges[n-1].hi == r-1 {
+			ranges[n-1].hi = r
+			continue
+		}
+		ranges = append(ranges, rng{r, r, p})
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package grapheme\n\n")
+	fmt.Fprintf(&b, "// unicodeVersion is the version of Unicode the table was generated from.\n")
+	fmt.Fprintf(&b, "const unicodeVersion = %q\n\n", unicode.Version)
+	fmt.Fprintf(&b, "// properties contains the Grapheme_Cluster_Break property of every rune\n")
+	fmt.Fprintf(&b, "// which is not propAny, excluding Hangul syllables.\n")
+	fmt.Fprintf(&b, "var properties = [...]propRange{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(&b, "\t{0x%04X, 0x%04X, %s},\n", r.lo, r.hi, propNames[r.prop])
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("tables.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
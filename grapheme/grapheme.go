@@ -0,0 +1,237 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package grapheme segments strings into extended grapheme clusters (user
+// perceived characters) according to the rules of Unicode Standard Annex #29.
+//
+// The Grapheme_Cluster_Break property table is generated by gen.go.
+//
+// Rule GB9c (Indic conjunct breaks) is not implemented.
+package grapheme
+
+//go:generate go run gen.go
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+type property uint8
+
+const (
+	propAny property = iota
+	propCR
+	propLF
+	propControl
+	propExtend
+	propZWJ
+	propRegionalIndicator
+	propPrepend
+	propSpacingMark
+	propL
+	propV
+	propT
+	propLV
+	propLVT
+	propExtendedPictographic
+)
+
+type propRange struct {
+	lo   rune
+	hi   rune
+	prop property
+}
+
+const (
+	hangulBase   = 0xAC00
+	hangulLast   = 0xD7A3
+	hangulTCount = 28
+)
+
+// lookup returns the Grapheme_Cluster_Break property of r.
+func lookup(r rune) property {
+	if r < utf8.RuneSelf {
+		switch {
+		case r == '\r':
+			return propCR
+		case r == '\n':
+			return propLF
+		case r < 0x20 || r == 0x7F:
+			return propControl
+		}
+		return propAny
+	}
+	if r >= hangulBase && r <= hangulLast {
+		if (r-hangulBase)%hangulTCount == 0 {
+			return propLV
+		}
+		return propLVT
+	}
+	i := sort.Search(len(properties), func(i int) bool {
+		return properties[i].hi >= r
+	})
+	if i < len(properties) && properties[i].lo <= r {
+		return properties[i].prop
+	}
+	return propAny
+}
+
+// Next returns the first extended grapheme cluster of s and the remainder of
+// s.
+//
+// Invalid UTF-8 bytes are treated as individual clusters.
+func Next(s string) (cluster string, rest string) {
+	if len(s) == 0 {
+		return "", ""
+	}
+	r, w := utf8.DecodeRuneInString(s)
+	if r < utf8.RuneSelf && r != '\r' && (len(s) == 1 || s[1] < utf8.RuneSelf) {
+		// ASCII followed by ASCII (or nothing) is always a boundary
+		return s[:1], s[1:]
+	}
+	prev := lookup(r)
+	if r == utf8.RuneError && w == 1 {
+		return s[:1], s[1:]
+	}
+	// number of consecutive regional indicators ending at prev
+	ri := 0
+	if prev == propRegionalIndicator {
+		ri = 1
+	}
+	// pict reports whether prev ends a sequence of
+	// Extended_Pictographic Extend*
+	pict := prev == propExtendedPictographic
+	// pictZWJ reports whether prev is a ZWJ which follows a sequence of
+	// Extended_Pictographic Extend*
+	pictZWJ := false
+
+	i := w
+	for i < len(s) {
+		r, w = utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && w == 1 {
+			break
+		}
+		cur := lookup(r)
+		if isBoundary(prev, cur, ri, pictZWJ) {
+			break
+		}
+		switch {
+		case cur == propExtendedPictographic:
+			pict, pictZWJ = true, false
+		case cur == propExtend && pict:
+			pictZWJ = false
+		case cur == propZWJ && pict:
+			pict, pictZWJ = false, true
+		default:
+			pict, pictZWJ = false, false
+		}
+		if cur == propRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+		prev = cur
+		i += w
+	}
+	return s[:i], s[i:]
+}
+
+// isBoundary reports whether there is a grapheme cluster boundary between
+// runes with the properties prev and cur.
+//
+// ri is the number of consecutive regional indicators preceding cur and
+// pictZWJ reports whether prev is a ZWJ which follows an
+// Extended_Pictographic rune and any number of Extend runes.
+func isBoundary(prev, cur property, ri int, pictZWJ bool) bool {
+	switch {
+	case prev == propCR && cur == propLF: // GB3
+		return false
+	case prev == propControl || prev == propCR || prev == propLF: // GB4
+		return true
+	case cur == propControl || cur == propCR || cur == propLF: // GB5
+		return true
+	case prev == propL && (cur == propL || cur == propV || cur == propLV || cur == propLVT): // GB6
+		return false
+	case (prev == propLV || prev == propV) && (cur == propV || cur == propT): // GB7
+		return false
+	case (prev == propLVT || prev == propT) && cur == propT: // GB8
+		return false
+	case cur == propExtend || cur == propZWJ: // GB9
+		return false
+	case cur == propSpacingMark: // GB9a
+		return false
+	case prev == propPrepend: // GB9b
+		return false
+	case prev == propZWJ && cur == propExtendedPictographic && pictZWJ: // GB11
+		return false
+	case prev == propRegionalIndicator && cur == propRegionalIndicator: // GB12, GB13
+		return ri%2 == 0
+	}
+	return true // GB999
+}
+
+// Split slices s into its extended grapheme clusters.
+func Split(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	res := make([]string, 0, utf8.RuneCountInString(s))
+	var c string
+	for len(s) > 0 {
+		c, s = Next(s)
+		res = append(res, c)
+	}
+	return res
+}
+
+// Count returns the number of extended grapheme clusters in s.
+func Count(s string) int {
+	n := 0
+	for len(s) > 0 {
+		_, s = Next(s)
+		n++
+	}
+	return n
+}
+
+// First returns the first extended grapheme cluster of s.
+func First(s string) string {
+	c, _ := Next(s)
+	return c
+}
+
+// Last returns the last extended grapheme cluster of s.
+func Last(s string) string {
+	var c string
+	for len(s) > 0 {
+		c, s = Next(s)
+	}
+	return c
+}
+
+// UnicodeVersion is the version of Unicode the property table was generated
+// from.
+func UnicodeVersion() string {
+	return unicodeVersion
+}
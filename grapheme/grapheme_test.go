@@ -0,0 +1,92 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package grapheme_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chanced/caps/grapheme"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"empty", "", nil},
+		{"ascii", "abc", []string{"a", "b", "c"}},
+		{"crlf", "a\r\nb", []string{"a", "\r\n", "b"}},
+		{"control", "a\x00\u0301", []string{"a", "\x00", "\u0301"}},
+		{"combining mark", "e\u0301te\u0301", []string{"e\u0301", "t", "e\u0301"}},
+		{"multiple combining marks", "a\u0308\u0301b", []string{"a\u0308\u0301", "b"}},
+		{"leading combining mark", "\u0301a", []string{"\u0301", "a"}},
+		{"hangul syllables", "한글", []string{"한", "글"}},
+		{"hangul jamo", "한\u1100", []string{"한", "\u1100"}},
+		{"regional indicators", "\U0001F1FA\U0001F1F8\U0001F1EB\U0001F1F7", []string{"\U0001F1FA\U0001F1F8", "\U0001F1EB\U0001F1F7"}},
+		{"odd regional indicators", "\U0001F1FA\U0001F1F8\U0001F1EB", []string{"\U0001F1FA\U0001F1F8", "\U0001F1EB"}},
+		{"emoji zwj sequence", "\U0001F469\u200d\U0001F4BBx", []string{"\U0001F469\u200d\U0001F4BB", "x"}},
+		{"emoji modifier", "\U0001F44D\U0001F3FDx", []string{"\U0001F44D\U0001F3FD", "x"}},
+		{"emoji modifier zwj sequence", "\U0001F469\U0001F3FD\u200d\U0001F4BB", []string{"\U0001F469\U0001F3FD\u200d\U0001F4BB"}},
+		{"zwj without pictographic", "a\u200d\U0001F4BB", []string{"a\u200d", "\U0001F4BB"}},
+		{"spacing mark", "\u0915\u093f", []string{"\u0915\u093f"}},
+		{"prepend", "\u0600\u0661", []string{"\u0600\u0661"}},
+		{"invalid utf8", "a\xffb", []string{"a", "\xff", "b"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := grapheme.Split(test.input)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %+q, got %+q", test.expected, got)
+			}
+			if n := grapheme.Count(test.input); n != len(test.expected) {
+				t.Errorf("expected Count to return %d, got %d", len(test.expected), n)
+			}
+		})
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	tests := []struct {
+		input string
+		first string
+		last  string
+	}{
+		{"", "", ""},
+		{"e\u0301clair", "e\u0301", "r"},
+		{"cafe\u0301", "c", "e\u0301"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := grapheme.First(test.input); got != test.first {
+				t.Errorf("expected First to return %+q, got %+q", test.first, got)
+			}
+			if got := grapheme.Last(test.input); got != test.last {
+				t.Errorf("expected Last to return %+q, got %+q", test.last, got)
+			}
+		})
+	}
+}
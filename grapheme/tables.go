@@ -0,0 +1,673 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package grapheme
+
+// unicodeVersion is the version of Unicode the table was generated from.
+const unicodeVersion = "17.0.0"
+
+// properties contains the Grapheme_Cluster_Break property of every rune
+// which is not propAny, excluding Hangul syllables.
+var properties = [...]propRange{
+	{0x0000, 0x0009, propControl},
+	{0x000A, 0x000A, propLF},
+	{0x000B, 0x000C, propControl},
+	{0x000D, 0x000D, propCR},
+	{0x000E, 0x001F, propControl},
+	{0x007F, 0x009F, propControl},
+	{0x00A9, 0x00A9, propExtendedPictographic},
+	{0x00AD, 0x00AD, propControl},
+	{0x00AE, 0x00AE, propExtendedPictographic},
+	{0x0300, 0x036F, propExtend},
+	{0x0483, 0x0489, propExtend},
+	{0x0591, 0x05BD, propExtend},
+	{0x05BF, 0x05BF, propExtend},
+	{0x05C1, 0x05C2, propExtend},
+	{0x05C4, 0x05C5, propExtend},
+	{0x05C7, 0x05C7, propExtend},
+	{0x0600, 0x0605, propPrepend},
+	{0x0610, 0x061A, propExtend},
+	{0x061C, 0x061C, propControl},
+	{0x064B, 0x065F, propExtend},
+	{0x0670, 0x0670, propExtend},
+	{0x06D6, 0x06DC, propExtend},
+	{0x06DD, 0x06DD, propPrepend},
+	{0x06DF, 0x06E4, propExtend},
+	{0x06E7, 0x06E8, propExtend},
+	{0x06EA, 0x06ED, propExtend},
+	{0x070F, 0x070F, propPrepend},
+	{0x0711, 0x0711, propExtend},
+	{0x0730, 0x074A, propExtend},
+	{0x07A6, 0x07B0, propExtend},
+	{0x07EB, 0x07F3, propExtend},
+	{0x07FD, 0x07FD, propExtend},
+	{0x0816, 0x0819, propExtend},
+	{0x081B, 0x0823, propExtend},
+	{0x0825, 0x0827, propExtend},
+	{0x0829, 0x082D, propExtend},
+	{0x0859, 0x085B, propExtend},
+	{0x0890, 0x0891, propPrepend},
+	{0x0897, 0x089F, propExtend},
+	{0x08CA, 0x08E1, propExtend},
+	{0x08E2, 0x08E2, propPrepend},
+	{0x08E3, 0x0902, propExtend},
+	{0x0903, 0x0903, propSpacingMark},
+	{0x093A, 0x093A, propExtend},
+	{0x093B, 0x093B, propSpacingMark},
+	{0x093C, 0x093C, propExtend},
+	{0x093E, 0x0940, propSpacingMark},
+	{0x0941, 0x0948, propExtend},
+	{0x0949, 0x094C, propSpacingMark},
+	{0x094D, 0x094D, propExtend},
+	{0x094E, 0x094F, propSpacingMark},
+	{0x0951, 0x0957, propExtend},
+	{0x0962, 0x0963, propExtend},
+	{0x0981, 0x0981, propExtend},
+	{0x0982, 0x0983, propSpacingMark},
+	{0x09BC, 0x09BC, propExtend},
+	{0x09BE, 0x09BE, propExtend},
+	{0x09BF, 0x09C0, propSpacingMark},
+	{0x09C1, 0x09C4, propExtend},
+	{0x09C7, 0x09C8, propSpacingMark},
+	{0x09CB, 0x09CC, propSpacingMark},
+	{0x09CD, 0x09CD, propExtend},
+	{0x09D7, 0x09D7, propExtend},
+	{0x09E2, 0x09E3, propExtend},
+	{0x09FE, 0x09FE, propExtend},
+	{0x0A01, 0x0A02, propExtend},
+	{0x0A03, 0x0A03, propSpacingMark},
+	{0x0A3C, 0x0A3C, propExtend},
+	{0x0A3E, 0x0A40, propSpacingMark},
+	{0x0A41, 0x0A42, propExtend},
+	{0x0A47, 0x0A48, propExtend},
+	{0x0A4B, 0x0A4D, propExtend},
+	{0x0A51, 0x0A51, propExtend},
+	{0x0A70, 0x0A71, propExtend},
+	{0x0A75, 0x0A75, propExtend},
+	{0x0A81, 0x0A82, propExtend},
+	{0x0A83, 0x0A83, propSpacingMark},
+	{0x0ABC, 0x0ABC, propExtend},
+	{0x0ABE, 0x0AC0, propSpacingMark},
+	{0x0AC1, 0x0AC5, propExtend},
+	{0x0AC7, 0x0AC8, propExtend},
+	{0x0AC9, 0x0AC9, propSpacingMark},
+	{0x0ACB, 0x0ACC, propSpacingMark},
+	{0x0ACD, 0x0ACD, propExtend},
+	{0x0AE2, 0x0AE3, propExtend},
+	{0x0AFA, 0x0AFF, propExtend},
+	{0x0B01, 0x0B01, propExtend},
+	{0x0B02, 0x0B03, propSpacingMark},
+	{0x0B3C, 0x0B3C, propExtend},
+	{0x0B3E, 0x0B3F, propExtend},
+	{0x0B40, 0x0B40, propSpacingMark},
+	{0x0B41, 0x0B44, propExtend},
+	{0x0B47, 0x0B48, propSpacingMark},
+	{0x0B4B, 0x0B4C, propSpacingMark},
+	{0x0B4D, 0x0B4D, propExtend},
+	{0x0B55, 0x0B57, propExtend},
+	{0x0B62, 0x0B63, propExtend},
+	{0x0B82, 0x0B82, propExtend},
+	{0x0BBE, 0x0BBE, propExtend},
+	{0x0BBF, 0x0BBF, propSpacingMark},
+	{0x0BC0, 0x0BC0, propExtend},
+	{0x0BC1, 0x0BC2, propSpacingMark},
+	{0x0BC6, 0x0BC8, propSpacingMark},
+	{0x0BCA, 0x0BCC, propSpacingMark},
+	{0x0BCD, 0x0BCD, propExtend},
+	{0x0BD7, 0x0BD7, propExtend},
+	{0x0C00, 0x0C00, propExtend},
+	{0x0C01, 0x0C03, propSpacingMark},
+	{0x0C04, 0x0C04, propExtend},
+	{0x0C3C, 0x0C3C, propExtend},
+	{0x0C3E, 0x0C40, propExtend},
+	{0x0C41, 0x0C44, propSpacingMark},
+	{0x0C46, 0x0C48, propExtend},
+	{0x0C4A, 0x0C4D, propExtend},
+	{0x0C55, 0x0C56, propExtend},
+	{0x0C62, 0x0C63, propExtend},
+	{0x0C81, 0x0C81, propExtend},
+	{0x0C82, 0x0C83, propSpacingMark},
+	{0x0CBC, 0x0CBC, propExtend},
+	{0x0CBE, 0x0CBE, propSpacingMark},
+	{0x0CBF, 0x0CC0, propExtend},
+	{0x0CC1, 0x0CC1, propSpacingMark},
+	{0x0CC2, 0x0CC2, propExtend},
+	{0x0CC3, 0x0CC4, propSpacingMark},
+	{0x0CC6, 0x0CC8, propExtend},
+	{0x0CCA, 0x0CCD, propExtend},
+	{0x0CD5, 0x0CD6, propExtend},
+	{0x0CE2, 0x0CE3, propExtend},
+	{0x0CF3, 0x0CF3, propSpacingMark},
+	{0x0D00, 0x0D01, propExtend},
+	{0x0D02, 0x0D03, propSpacingMark},
+	{0x0D3B, 0x0D3C, propExtend},
+	{0x0D3E, 0x0D3E, propExtend},
+	{0x0D3F, 0x0D40, propSpacingMark},
+	{0x0D41, 0x0D44, propExtend},
+	{0x0D46, 0x0D48, propSpacingMark},
+	{0x0D4A, 0x0D4C, propSpacingMark},
+	{0x0D4D, 0x0D4D, propExtend},
+	{0x0D4E, 0x0D4E, propPrepend},
+	{0x0D57, 0x0D57, propExtend},
+	{0x0D62, 0x0D63, propExtend},
+	{0x0D81, 0x0D81, propExtend},
+	{0x0D82, 0x0D83, propSpacingMark},
+	{0x0DCA, 0x0DCA, propExtend},
+	{0x0DCF, 0x0DCF, propExtend},
+	{0x0DD0, 0x0DD1, propSpacingMark},
+	{0x0DD2, 0x0DD4, propExtend},
+	{0x0DD6, 0x0DD6, propExtend},
+	{0x0DD8, 0x0DDE, propSpacingMark},
+	{0x0DDF, 0x0DDF, propExtend},
+	{0x0DF2, 0x0DF3, propSpacingMark},
+	{0x0E31, 0x0E31, propExtend},
+	{0x0E33, 0x0E33, propSpacingMark},
+	{0x0E34, 0x0E3A, propExtend},
+	{0x0E47, 0x0E4E, propExtend},
+	{0x0EB1, 0x0EB1, propExtend},
+	{0x0EB3, 0x0EB3, propSpacingMark},
+	{0x0EB4, 0x0EBC, propExtend},
+	{0x0EC8, 0x0ECE, propExtend},
+	{0x0F18, 0x0F19, propExtend},
+	{0x0F35, 0x0F35, propExtend},
+	{0x0F37, 0x0F37, propExtend},
+	{0x0F39, 0x0F39, propExtend},
+	{0x0F3E, 0x0F3F, propSpacingMark},
+	{0x0F71, 0x0F7E, propExtend},
+	{0x0F7F, 0x0F7F, propSpacingMark},
+	{0x0F80, 0x0F84, propExtend},
+	{0x0F86, 0x0F87, propExtend},
+	{0x0F8D, 0x0F97, propExtend},
+	{0x0F99, 0x0FBC, propExtend},
+	{0x0FC6, 0x0FC6, propExtend},
+	{0x102D, 0x1030, propExtend},
+	{0x1031, 0x1031, propSpacingMark},
+	{0x1032, 0x1037, propExtend},
+	{0x1039, 0x103A, propExtend},
+	{0x103B, 0x103C, propSpacingMark},
+	{0x103D, 0x103E, propExtend},
+	{0x1056, 0x1057, propSpacingMark},
+	{0x1058, 0x1059, propExtend},
+	{0x105E, 0x1060, propExtend},
+	{0x1071, 0x1074, propExtend},
+	{0x1082, 0x1082, propExtend},
+	{0x1084, 0x1084, propSpacingMark},
+	{0x1085, 0x1086, propExtend},
+	{0x108D, 0x108D, propExtend},
+	{0x109D, 0x109D, propExtend},
+	{0x1100, 0x115F, propL},
+	{0x1160, 0x11A7, propV},
+	{0x11A8, 0x11FF, propT},
+	{0x135D, 0x135F, propExtend},
+	{0x1712, 0x1715, propExtend},
+	{0x1732, 0x1734, propExtend},
+	{0x1752, 0x1753, propExtend},
+	{0x1772, 0x1773, propExtend},
+	{0x17B4, 0x17B5, propExtend},
+	{0x17B6, 0x17B6, propSpacingMark},
+	{0x17B7, 0x17BD, propExtend},
+	{0x17BE, 0x17C5, propSpacingMark},
+	{0x17C6, 0x17C6, propExtend},
+	{0x17C7, 0x17C8, propSpacingMark},
+	{0x17C9, 0x17D3, propExtend},
+	{0x17DD, 0x17DD, propExtend},
+	{0x180B, 0x180D, propExtend},
+	{0x180E, 0x180E, propControl},
+	{0x180F, 0x180F, propExtend},
+	{0x1885, 0x1886, propExtend},
+	{0x18A9, 0x18A9, propExtend},
+	{0x1920, 0x1922, propExtend},
+	{0x1923, 0x1926, propSpacingMark},
+	{0x1927, 0x1928, propExtend},
+	{0x1929, 0x192B, propSpacingMark},
+	{0x1930, 0x1931, propSpacingMark},
+	{0x1932, 0x1932, propExtend},
+	{0x1933, 0x1938, propSpacingMark},
+	{0x1939, 0x193B, propExtend},
+	{0x1A17, 0x1A18, propExtend},
+	{0x1A19, 0x1A1A, propSpacingMark},
+	{0x1A1B, 0x1A1B, propExtend},
+	{0x1A55, 0x1A55, propSpacingMark},
+	{0x1A56, 0x1A56, propExtend},
+	{0x1A57, 0x1A57, propSpacingMark},
+	{0x1A58, 0x1A5E, propExtend},
+	{0x1A60, 0x1A60, propExtend},
+	{0x1A62, 0x1A62, propExtend},
+	{0x1A65, 0x1A6C, propExtend},
+	{0x1A6D, 0x1A72, propSpacingMark},
+	{0x1A73, 0x1A7C, propExtend},
+	{0x1A7F, 0x1A7F, propExtend},
+	{0x1AB0, 0x1ADD, propExtend},
+	{0x1AE0, 0x1AEB, propExtend},
+	{0x1B00, 0x1B03, propExtend},
+	{0x1B04, 0x1B04, propSpacingMark},
+	{0x1B34, 0x1B3D, propExtend},
+	{0x1B3E, 0x1B41, propSpacingMark},
+	{0x1B42, 0x1B44, propExtend},
+	{0x1B6B, 0x1B73, propExtend},
+	{0x1B80, 0x1B81, propExtend},
+	{0x1B82, 0x1B82, propSpacingMark},
+	{0x1BA1, 0x1BA1, propSpacingMark},
+	{0x1BA2, 0x1BA5, propExtend},
+	{0x1BA6, 0x1BA7, propSpacingMark},
+	{0x1BA8, 0x1BAD, propExtend},
+	{0x1BE6, 0x1BE6, propExtend},
+	{0x1BE7, 0x1BE7, propSpacingMark},
+	{0x1BE8, 0x1BE9, propExtend},
+	{0x1BEA, 0x1BEC, propSpacingMark},
+	{0x1BED, 0x1BED, propExtend},
+	{0x1BEE, 0x1BEE, propSpacingMark},
+	{0x1BEF, 0x1BF3, propExtend},
+	{0x1C24, 0x1C2B, propSpacingMark},
+	{0x1C2C, 0x1C33, propExtend},
+	{0x1C34, 0x1C35, propSpacingMark},
+	{0x1C36, 0x1C37, propExtend},
+	{0x1CD0, 0x1CD2, propExtend},
+	{0x1CD4, 0x1CE0, propExtend},
+	{0x1CE1, 0x1CE1, propSpacingMark},
+	{0x1CE2, 0x1CE8, propExtend},
+	{0x1CED, 0x1CED, propExtend},
+	{0x1CF4, 0x1CF4, propExtend},
+	{0x1CF7, 0x1CF7, propSpacingMark},
+	{0x1CF8, 0x1CF9, propExtend},
+	{0x1DC0, 0x1DFF, propExtend},
+	{0x200B, 0x200B, propControl},
+	{0x200C, 0x200C, propExtend},
+	{0x200D, 0x200D, propZWJ},
+	{0x200E, 0x200F, propControl},
+	{0x2028, 0x202E, propControl},
+	{0x203C, 0x203C, propExtendedPictographic},
+	{0x2049, 0x2049, propExtendedPictographic},
+	{0x2060, 0x2064, propControl},
+	{0x2066, 0x206F, propControl},
+	{0x20D0, 0x20F0, propExtend},
+	{0x2122, 0x2122, propExtendedPictographic},
+	{0x2139, 0x2139, propExtendedPictographic},
+	{0x2194, 0x2199, propExtendedPictographic},
+	{0x21A9, 0x21AA, propExtendedPictographic},
+	{0x231A, 0x231B, propExtendedPictographic},
+	{0x2328, 0x2328, propExtendedPictographic},
+	{0x2388, 0x2388, propExtendedPictographic},
+	{0x23CF, 0x23CF, propExtendedPictographic},
+	{0x23E9, 0x23F3, propExtendedPictographic},
+	{0x23F8, 0x23FA, propExtendedPictographic},
+	{0x24C2, 0x24C2, propExtendedPictographic},
+	{0x25AA, 0x25AB, propExtendedPictographic},
+	{0x25B6, 0x25B6, propExtendedPictographic},
+	{0x25C0, 0x25C0, propExtendedPictographic},
+	{0x25FB, 0x25FE, propExtendedPictographic},
+	{0x2600, 0x2605, propExtendedPictographic},
+	{0x2607, 0x2612, propExtendedPictographic},
+	{0x2614, 0x2685, propExtendedPictographic},
+	{0x2690, 0x2705, propExtendedPictographic},
+	{0x2708, 0x2712, propExtendedPictographic},
+	{0x2714, 0x2714, propExtendedPictographic},
+	{0x2716, 0x2716, propExtendedPictographic},
+	{0x271D, 0x271D, propExtendedPictographic},
+	{0x2721, 0x2721, propExtendedPictographic},
+	{0x2728, 0x2728, propExtendedPictographic},
+	{0x2733, 0x2734, propExtendedPictographic},
+	{0x2744, 0x2744, propExtendedPictographic},
+	{0x2747, 0x2747, propExtendedPictographic},
+	{0x274C, 0x274C, propExtendedPictographic},
+	{0x274E, 0x274E, propExtendedPictographic},
+	{0x2753, 0x2755, propExtendedPictographic},
+	{0x2757, 0x2757, propExtendedPictographic},
+	{0x2763, 0x2767, propExtendedPictographic},
+	{0x2795, 0x2797, propExtendedPictographic},
+	{0x27A1, 0x27A1, propExtendedPictographic},
+	{0x27B0, 0x27B0, propExtendedPictographic},
+	{0x27BF, 0x27BF, propExtendedPictographic},
+	{0x2934, 0x2935, propExtendedPictographic},
+	{0x2B05, 0x2B07, propExtendedPictographic},
+	{0x2B1B, 0x2B1C, propExtendedPictographic},
+	{0x2B50, 0x2B50, propExtendedPictographic},
+	{0x2B55, 0x2B55, propExtendedPictographic},
+	{0x2CEF, 0x2CF1, propExtend},
+	{0x2D7F, 0x2D7F, propExtend},
+	{0x2DE0, 0x2DFF, propExtend},
+	{0x302A, 0x302F, propExtend},
+	{0x3030, 0x3030, propExtendedPictographic},
+	{0x303D, 0x303D, propExtendedPictographic},
+	{0x3099, 0x309A, propExtend},
+	{0x3297, 0x3297, propExtendedPictographic},
+	{0x3299, 0x3299, propExtendedPictographic},
+	{0xA66F, 0xA672, propExtend},
+	{0xA674, 0xA67D, propExtend},
+	{0xA69E, 0xA69F, propExtend},
+	{0xA6F0, 0xA6F1, propExtend},
+	{0xA802, 0xA802, propExtend},
+	{0xA806, 0xA806, propExtend},
+	{0xA80B, 0xA80B, propExtend},
+	{0xA823, 0xA824, propSpacingMark},
+	{0xA825, 0xA826, propExtend},
+	{0xA827, 0xA827, propSpacingMark},
+	{0xA82C, 0xA82C, propExtend},
+	{0xA880, 0xA881, propSpacingMark},
+	{0xA8B4, 0xA8C3, propSpacingMark},
+	{0xA8C4, 0xA8C5, propExtend},
+	{0xA8E0, 0xA8F1, propExtend},
+	{0xA8FF, 0xA8FF, propExtend},
+	{0xA926, 0xA92D, propExtend},
+	{0xA947, 0xA951, propExtend},
+	{0xA952, 0xA952, propSpacingMark},
+	{0xA953, 0xA953, propExtend},
+	{0xA960, 0xA97C, propL},
+	{0xA980, 0xA982, propExtend},
+	{0xA983, 0xA983, propSpacingMark},
+	{0xA9B3, 0xA9B3, propExtend},
+	{0xA9B4, 0xA9B5, propSpacingMark},
+	{0xA9B6, 0xA9B9, propExtend},
+	{0xA9BA, 0xA9BB, propSpacingMark},
+	{0xA9BC, 0xA9BD, propExtend},
+	{0xA9BE, 0xA9BF, propSpacingMark},
+	{0xA9C0, 0xA9C0, propExtend},
+	{0xA9E5, 0xA9E5, propExtend},
+	{0xAA29, 0xAA2E, propExtend},
+	{0xAA2F, 0xAA30, propSpacingMark},
+	{0xAA31, 0xAA32, propExtend},
+	{0xAA33, 0xAA34, propSpacingMark},
+	{0xAA35, 0xAA36, propExtend},
+	{0xAA43, 0xAA43, propExtend},
+	{0xAA4C, 0xAA4C, propExtend},
+	{0xAA4D, 0xAA4D, propSpacingMark},
+	{0xAA7C, 0xAA7C, propExtend},
+	{0xAAB0, 0xAAB0, propExtend},
+	{0xAAB2, 0xAAB4, propExtend},
+	{0xAAB7, 0xAAB8, propExtend},
+	{0xAABE, 0xAABF, propExtend},
+	{0xAAC1, 0xAAC1, propExtend},
+	{0xAAEB, 0xAAEB, propSpacingMark},
+	{0xAAEC, 0xAAED, propExtend},
+	{0xAAEE, 0xAAEF, propSpacingMark},
+	{0xAAF5, 0xAAF5, propSpacingMark},
+	{0xAAF6, 0xAAF6, propExtend},
+	{0xABE3, 0xABE4, propSpacingMark},
+	{0xABE5, 0xABE5, propExtend},
+	{0xABE6, 0xABE7, propSpacingMark},
+	{0xABE8, 0xABE8, propExtend},
+	{0xABE9, 0xABEA, propSpacingMark},
+	{0xABEC, 0xABEC, propSpacingMark},
+	{0xABED, 0xABED, propExtend},
+	{0xD7B0, 0xD7C6, propV},
+	{0xD7CB, 0xD7FB, propT},
+	{0xFB1E, 0xFB1E, propExtend},
+	{0xFE00, 0xFE0F, propExtend},
+	{0xFE20, 0xFE2F, propExtend},
+	{0xFEFF, 0xFEFF, propControl},
+	{0xFF9E, 0xFF9F, propExtend},
+	{0xFFF9, 0xFFFB, propControl},
+	{0x101FD, 0x101FD, propExtend},
+	{0x102E0, 0x102E0, propExtend},
+	{0x10376, 0x1037A, propExtend},
+	{0x10A01, 0x10A03, propExtend},
+	{0x10A05, 0x10A06, propExtend},
+	{0x10A0C, 0x10A0F, propExtend},
+	{0x10A38, 0x10A3A, propExtend},
+	{0x10A3F, 0x10A3F, propExtend},
+	{0x10AE5, 0x10AE6, propExtend},
+	{0x10D24, 0x10D27, propExtend},
+	{0x10D69, 0x10D6D, propExtend},
+	{0x10EAB, 0x10EAC, propExtend},
+	{0x10EFA, 0x10EFF, propExtend},
+	{0x10F46, 0x10F50, propExtend},
+	{0x10F82, 0x10F85, propExtend},
+	{0x11000, 0x11000, propSpacingMark},
+	{0x11001, 0x11001, propExtend},
+	{0x11002, 0x11002, propSpacingMark},
+	{0x11038, 0x11046, propExtend},
+	{0x11070, 0x11070, propExtend},
+	{0x11073, 0x11074, propExtend},
+	{0x1107F, 0x11081, propExtend},
+	{0x11082, 0x11082, propSpacingMark},
+	{0x110B0, 0x110B2, propSpacingMark},
+	{0x110B3, 0x110B6, propExtend},
+	{0x110B7, 0x110B8, propSpacingMark},
+	{0x110B9, 0x110BA, propExtend},
+	{0x110BD, 0x110BD, propPrepend},
+	{0x110C2, 0x110C2, propExtend},
+	{0x110CD, 0x110CD, propPrepend},
+	{0x11100, 0x11102, propExtend},
+	{0x11127, 0x1112B, propExtend},
+	{0x1112C, 0x1112C, propSpacingMark},
+	{0x1112D, 0x11134, propExtend},
+	{0x11145, 0x11146, propSpacingMark},
+	{0x11173, 0x11173, propExtend},
+	{0x11180, 0x11181, propExtend},
+	{0x11182, 0x11182, propSpacingMark},
+	{0x111B3, 0x111B5, propSpacingMark},
+	{0x111B6, 0x111BE, propExtend},
+	{0x111BF, 0x111BF, propSpacingMark},
+	{0x111C0, 0x111C0, propExtend},
+	{0x111C2, 0x111C3, propPrepend},
+	{0x111C9, 0x111CC, propExtend},
+	{0x111CE, 0x111CE, propSpacingMark},
+	{0x111CF, 0x111CF, propExtend},
+	{0x1122C, 0x1122E, propSpacingMark},
+	{0x1122F, 0x11231, propExtend},
+	{0x11232, 0x11233, propSpacingMark},
+	{0x11234, 0x11237, propExtend},
+	{0x1123E, 0x1123E, propExtend},
+	{0x11241, 0x11241, propExtend},
+	{0x112DF, 0x112DF, propExtend},
+	{0x112E0, 0x112E2, propSpacingMark},
+	{0x112E3, 0x112EA, propExtend},
+	{0x11300, 0x11301, propExtend},
+	{0x11302, 0x11303, propSpacingMark},
+	{0x1133B, 0x1133C, propExtend},
+	{0x1133E, 0x1133E, propExtend},
+	{0x1133F, 0x1133F, propSpacingMark},
+	{0x11340, 0x11340, propExtend},
+	{0x11341, 0x11344, propSpacingMark},
+	{0x11347, 0x11348, propSpacingMark},
+	{0x1134B, 0x1134C, propSpacingMark},
+	{0x1134D, 0x1134D, propExtend},
+	{0x11357, 0x11357, propExtend},
+	{0x11362, 0x11363, propSpacingMark},
+	{0x11366, 0x1136C, propExtend},
+	{0x11370, 0x11374, propExtend},
+	{0x113B8, 0x113B8, propExtend},
+	{0x113B9, 0x113BA, propSpacingMark},
+	{0x113BB, 0x113C0, propExtend},
+	{0x113C2, 0x113C2, propExtend},
+	{0x113C5, 0x113C5, propExtend},
+	{0x113C7, 0x113C9, propExtend},
+	{0x113CA, 0x113CA, propSpacingMark},
+	{0x113CC, 0x113CD, propSpacingMark},
+	{0x113CE, 0x113D0, propExtend},
+	{0x113D2, 0x113D2, propExtend},
+	{0x113E1, 0x113E2, propExtend},
+	{0x11435, 0x11437, propSpacingMark},
+	{0x11438, 0x1143F, propExtend},
+	{0x11440, 0x11441, propSpacingMark},
+	{0x11442, 0x11444, propExtend},
+	{0x11445, 0x11445, propSpacingMark},
+	{0x11446, 0x11446, propExtend},
+	{0x1145E, 0x1145E, propExtend},
+	{0x114B0, 0x114B0, propExtend},
+	{0x114B1, 0x114B2, propSpacingMark},
+	{0x114B3, 0x114B8, propExtend},
+	{0x114B9, 0x114B9, propSpacingMark},
+	{0x114BA, 0x114BA, propExtend},
+	{0x114BB, 0x114BC, propSpacingMark},
+	{0x114BD, 0x114BD, propExtend},
+	{0x114BE, 0x114BE, propSpacingMark},
+	{0x114BF, 0x114C0, propExtend},
+	{0x114C1, 0x114C1, propSpacingMark},
+	{0x114C2, 0x114C3, propExtend},
+	{0x115AF, 0x115AF, propExtend},
+	{0x115B0, 0x115B1, propSpacingMark},
+	{0x115B2, 0x115B5, propExtend},
+	{0x115B8, 0x115BB, propSpacingMark},
+	{0x115BC, 0x115BD, propExtend},
+	{0x115BE, 0x115BE, propSpacingMark},
+	{0x115BF, 0x115C0, propExtend},
+	{0x115DC, 0x115DD, propExtend},
+	{0x11630, 0x11632, propSpacingMark},
+	{0x11633, 0x1163A, propExtend},
+	{0x1163B, 0x1163C, propSpacingMark},
+	{0x1163D, 0x1163D, propExtend},
+	{0x1163E, 0x1163E, propSpacingMark},
+	{0x1163F, 0x11640, propExtend},
+	{0x116AB, 0x116AB, propExtend},
+	{0x116AC, 0x116AC, propSpacingMark},
+	{0x116AD, 0x116AD, propExtend},
+	{0x116AE, 0x116AF, propSpacingMark},
+	{0x116B0, 0x116B7, propExtend},
+	{0x1171D, 0x1171D, propExtend},
+	{0x1171E, 0x1171E, propSpacingMark},
+	{0x1171F, 0x1171F, propExtend},
+	{0x11722, 0x11725, propExtend},
+	{0x11726, 0x11726, propSpacingMark},
+	{0x11727, 0x1172B, propExtend},
+	{0x1182C, 0x1182E, propSpacingMark},
+	{0x1182F, 0x11837, propExtend},
+	{0x11838, 0x11838, propSpacingMark},
+	{0x11839, 0x1183A, propExtend},
+	{0x11930, 0x11930, propExtend},
+	{0x11931, 0x11935, propSpacingMark},
+	{0x11937, 0x11938, propSpacingMark},
+	{0x1193B, 0x1193E, propExtend},
+	{0x1193F, 0x1193F, propPrepend},
+	{0x11940, 0x11940, propSpacingMark},
+	{0x11941, 0x11941, propPrepend},
+	{0x11942, 0x11942, propSpacingMark},
+	{0x11943, 0x11943, propExtend},
+	{0x119D1, 0x119D3, propSpacingMark},
+	{0x119D4, 0x119D7, propExtend},
+	{0x119DA, 0x119DB, propExtend},
+	{0x119DC, 0x119DF, propSpacingMark},
+	{0x119E0, 0x119E0, propExtend},
+	{0x119E4, 0x119E4, propSpacingMark},
+	{0x11A01, 0x11A0A, propExtend},
+	{0x11A33, 0x11A38, propExtend},
+	{0x11A39, 0x11A39, propSpacingMark},
+	{0x11A3A, 0x11A3A, propPrepend},
+	{0x11A3B, 0x11A3E, propExtend},
+	{0x11A47, 0x11A47, propExtend},
+	{0x11A51, 0x11A56, propExtend},
+	{0x11A57, 0x11A58, propSpacingMark},
+	{0x11A59, 0x11A5B, propExtend},
+	{0x11A84, 0x11A89, propPrepend},
+	{0x11A8A, 0x11A96, propExtend},
+	{0x11A97, 0x11A97, propSpacingMark},
+	{0x11A98, 0x11A99, propExtend},
+	{0x11B60, 0x11B60, propExtend},
+	{0x11B61, 0x11B61, propSpacingMark},
+	{0x11B62, 0x11B64, propExtend},
+	{0x11B65, 0x11B65, propSpacingMark},
+	{0x11B66, 0x11B66, propExtend},
+	{0x11B67, 0x11B67, propSpacingMark},
+	{0x11C2F, 0x11C2F, propSpacingMark},
+	{0x11C30, 0x11C36, propExtend},
+	{0x11C38, 0x11C3D, propExtend},
+	{0x11C3E, 0x11C3E, propSpacingMark},
+	{0x11C3F, 0x11C3F, propExtend},
+	{0x11C92, 0x11CA7, propExtend},
+	{0x11CA9, 0x11CA9, propSpacingMark},
+	{0x11CAA, 0x11CB0, propExtend},
+	{0x11CB1, 0x11CB1, propSpacingMark},
+	{0x11CB2, 0x11CB3, propExtend},
+	{0x11CB4, 0x11CB4, propSpacingMark},
+	{0x11CB5, 0x11CB6, propExtend},
+	{0x11D31, 0x11D36, propExtend},
+	{0x11D3A, 0x11D3A, propExtend},
+	{0x11D3C, 0x11D3D, propExtend},
+	{0x11D3F, 0x11D45, propExtend},
+	{0x11D46, 0x11D46, propPrepend},
+	{0x11D47, 0x11D47, propExtend},
+	{0x11D8A, 0x11D8E, propSpacingMark},
+	{0x11D90, 0x11D91, propExtend},
+	{0x11D93, 0x11D94, propSpacingMark},
+	{0x11D95, 0x11D95, propExtend},
+	{0x11D96, 0x11D96, propSpacingMark},
+	{0x11D97, 0x11D97, propExtend},
+	{0x11EF3, 0x11EF4, propExtend},
+	{0x11EF5, 0x11EF6, propSpacingMark},
+	{0x11F00, 0x11F01, propExtend},
+	{0x11F03, 0x11F03, propSpacingMark},
+	{0x11F34, 0x11F35, propSpacingMark},
+	{0x11F36, 0x11F3A, propExtend},
+	{0x11F3E, 0x11F3F, propSpacingMark},
+	{0x11F40, 0x11F42, propExtend},
+	{0x11F5A, 0x11F5A, propExtend},
+	{0x13430, 0x1343F, propControl},
+	{0x13440, 0x13440, propExtend},
+	{0x13447, 0x13455, propExtend},
+	{0x1611E, 0x16129, propExtend},
+	{0x1612A, 0x1612C, propSpacingMark},
+	{0x1612D, 0x1612F, propExtend},
+	{0x16AF0, 0x16AF4, propExtend},
+	{0x16B30, 0x16B36, propExtend},
+	{0x16F4F, 0x16F4F, propExtend},
+	{0x16F51, 0x16F87, propSpacingMark},
+	{0x16F8F, 0x16F92, propExtend},
+	{0x16FE4, 0x16FE4, propExtend},
+	{0x16FF0, 0x16FF1, propExtend},
+	{0x1BC9D, 0x1BC9E, propExtend},
+	{0x1BCA0, 0x1BCA3, propControl},
+	{0x1CF00, 0x1CF2D, propExtend},
+	{0x1CF30, 0x1CF46, propExtend},
+	{0x1D165, 0x1D169, propExtend},
+	{0x1D16D, 0x1D172, propExtend},
+	{0x1D173, 0x1D17A, propControl},
+	{0x1D17B, 0x1D182, propExtend},
+	{0x1D185, 0x1D18B, propExtend},
+	{0x1D1AA, 0x1D1AD, propExtend},
+	{0x1D242, 0x1D244, propExtend},
+	{0x1DA00, 0x1DA36, propExtend},
+	{0x1DA3B, 0x1DA6C, propExtend},
+	{0x1DA75, 0x1DA75, propExtend},
+	{0x1DA84, 0x1DA84, propExtend},
+	{0x1DA9B, 0x1DA9F, propExtend},
+	{0x1DAA1, 0x1DAAF, propExtend},
+	{0x1E000, 0x1E006, propExtend},
+	{0x1E008, 0x1E018, propExtend},
+	{0x1E01B, 0x1E021, propExtend},
+	{0x1E023, 0x1E024, propExtend},
+	{0x1E026, 0x1E02A, propExtend},
+	{0x1E08F, 0x1E08F, propExtend},
+	{0x1E130, 0x1E136, propExtend},
+	{0x1E2AE, 0x1E2AE, propExtend},
+	{0x1E2EC, 0x1E2EF, propExtend},
+	{0x1E4EC, 0x1E4EF, propExtend},
+	{0x1E5EE, 0x1E5EF, propExtend},
+	{0x1E6E3, 0x1E6E3, propExtend},
+	{0x1E6E6, 0x1E6E6, propExtend},
+	{0x1E6EE, 0x1E6EF, propExtend},
+	{0x1E6F5, 0x1E6F5, propExtend},
+	{0x1E8D0, 0x1E8D6, propExtend},
+	{0x1E944, 0x1E94A, propExtend},
+	{0x1F000, 0x1F0FF, propExtendedPictographic},
+	{0x1F10D, 0x1F10F, propExtendedPictographic},
+	{0x1F12F, 0x1F12F, propExtendedPictographic},
+	{0x1F16C, 0x1F171, propExtendedPictographic},
+	{0x1F17E, 0x1F17F, propExtendedPictographic},
+	{0x1F18E, 0x1F18E, propExtendedPictographic},
+	{0x1F191, 0x1F19A, propExtendedPictographic},
+	{0x1F1AD, 0x1F1E5, propExtendedPictographic},
+	{0x1F1E6, 0x1F1FF, propRegionalIndicator},
+	{0x1F201, 0x1F20F, propExtendedPictographic},
+	{0x1F21A, 0x1F21A, propExtendedPictographic},
+	{0x1F22F, 0x1F22F, propExtendedPictographic},
+	{0x1F232, 0x1F23A, propExtendedPictographic},
+	{0x1F23C, 0x1F23F, propExtendedPictographic},
+	{0x1F249, 0x1F3FA, propExtendedPictographic},
+	{0x1F3FB, 0x1F3FF, propExtend},
+	{0x1F400, 0x1F53D, propExtendedPictographic},
+	{0x1F546, 0x1F64F, propExtendedPictographic},
+	{0x1F680, 0x1F6FF, propExtendedPictographic},
+	{0x1F774, 0x1F77F, propExtendedPictographic},
+	{0x1F7D5, 0x1F7FF, propExtendedPictographic},
+	{0x1F80C, 0x1F80F, propExtendedPictographic},
+	{0x1F848, 0x1F84F, propExtendedPictographic},
+	{0x1F85A, 0x1F85F, propExtendedPictographic},
+	{0x1F888, 0x1F88F, propExtendedPictographic},
+	{0x1F8AE, 0x1F8FF, propExtendedPictographic},
+	{0x1F90C, 0x1F93A, propExtendedPictographic},
+	{0x1F93C, 0x1F945, propExtendedPictographic},
+	{0x1F947, 0x1FAFF, propExtendedPictographic},
+	{0x1FC00, 0x1FFFD, propExtendedPictographic},
+	{0xE0001, 0xE0001, propControl},
+	{0xE0020, 0xE007F, propExtend},
+	{0xE0100, 0xE01EF, propExtend},
+}
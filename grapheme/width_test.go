@@ -0,0 +1,64 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package grapheme_test
+
+import (
+	"testing"
+
+	"github.com/chanced/caps/grapheme"
+)
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{"empty", "", 0},
+		{"ascii", "abc", 3},
+		{"control", "a\tb\x00", 2},
+		{"combining mark", "e\u0301te\u0301", 3},
+		{"leading combining mark", "\u0301a", 1},
+		{"han", "用户ID", 6},
+		{"hangul", "한글", 4},
+		{"hangul jamo", "\u1100\u1161\u11A8", 2},
+		{"fullwidth", "ＡＢ", 4},
+		{"halfwidth katakana", "ｶﾀｶﾅ", 4},
+		{"emoji", "\U0001F600", 2},
+		{"emoji presentation", "\u2764\uFE0F", 2},
+		{"text presentation", "\u2764", 1},
+		{"flag", "\U0001F1FA\U0001F1F8", 2},
+		{"emoji zwj sequence", "\U0001F469\u200d\U0001F4BB", 2},
+		{"zero width space", "a\u200bb", 2},
+		{"invalid utf8", "a\xffb", 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := grapheme.Width(test.input); got != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
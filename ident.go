@@ -0,0 +1,188 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// NormalizeKey returns the normalized identifier of str. Identifiers which
+// name the same thing in different conventions (e.g. "user_id", "UserID",
+// "userId" and "USER-ID") share the same normalized key.
+//
+// The key is produced by tokenizing str with the Converter, applying
+// replacements, joining the words with "_" and case folding the result.
+//
+//	caps.NormalizeKey("UserID") // user_id
+//	caps.NormalizeKey("USER-ID") // user_id
+func NormalizeKey[T ~string](str T, options ...Opts) T {
+	opts := loadOpts(options)
+	return T(normalizeKey(opts.Converter, string(str), opts.AllowedSymbols, opts.NumberRules))
+}
+
+// EqualIdent reports whether a and b name the same identifier, regardless of
+// their convention and case.
+//
+//	caps.EqualIdent("user_id", "UserID") // true
+//	caps.EqualIdent("userId", "USER-ID") // true
+//	caps.EqualIdent("user_id", "userid") // false
+func EqualIdent[T ~string](a, b T, options ...Opts) bool {
+	opts := loadOpts(options)
+	return normalizeKey(opts.Converter, string(a), opts.AllowedSymbols, opts.NumberRules) ==
+		normalizeKey(opts.Converter, string(b), opts.AllowedSymbols, opts.NumberRules)
+}
+
+func normalizeKey(converter Converter, str string, allowed string, numberRules NumberRules) string {
+	return foldString(converter.Convert(ConvertRequest{
+		Style:          StyleLower,
+		ReplaceStyle:   ReplaceStyleLower,
+		Input:          str,
+		Join:           "_",
+		AllowedSymbols: allowed,
+		NumberRules:    numberRules,
+	}))
+}
+
+// foldString maps each rune of s to the lowercase form of the smallest rune of
+// its simple case folding orbit so that strings which are equal under simple
+// case folding are identical.
+func foldString(s string) string {
+	return strings.Map(foldRune, s)
+}
+
+func foldRune(r rune) rune {
+	lo := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < lo {
+			lo = f
+		}
+	}
+	return unicode.ToLower(lo)
+}
+
+// Map is a map keyed by identifiers. Keys which are EqualIdent (e.g.
+// "user_id" and "UserID") refer to the same entry.
+//
+// The zero value is ready to use and normalizes keys with the
+// DefaultConverter. A Map is not safe for concurrent use.
+type Map[V any] struct {
+	normalize func(string) string
+	entries   map[string]mapEntry[V]
+}
+
+type mapEntry[V any] struct {
+	key   string
+	value V
+}
+
+// NewMap returns a new Map which normalizes keys with the provided options.
+func NewMap[V any](options ...Opts) *Map[V] {
+	opts := loadOpts(options)
+	return &Map[V]{
+		normalize: func(s string) string {
+			return normalizeKey(opts.Converter, s, opts.AllowedSymbols, opts.NumberRules)
+		},
+	}
+}
+
+func (m *Map[V]) key(key string) string {
+	if m.normalize == nil {
+		return normalizeKey(DefaultConverter, key, "", nil)
+	}
+	return m.normalize(key)
+}
+
+// Set sets the value of key, replacing the key and value of any entry with
+// an equivalent identifier.
+func (m *Map[V]) Set(key string, value V) {
+	if m.entries == nil {
+		m.entries = make(map[string]mapEntry[V])
+	}
+	m.entries[m.key(key)] = mapEntry[V]{key: key, value: value}
+}
+
+// Get returns the value of the entry whose identifier is equivalent to key.
+func (m *Map[V]) Get(key string) (V, bool) {
+	e, ok := m.entries[m.key(key)]
+	return e.value, ok
+}
+
+// Key returns the key, as it was last set, of the entry whose identifier is
+// equivalent to key.
+func (m *Map[V]) Key(key string) (string, bool) {
+	e, ok := m.entries[m.key(key)]
+	return e.key, ok
+}
+
+// Has reports whether m contains an entry whose identifier is equivalent to
+// key.
+func (m *Map[V]) Has(key string) bool {
+	_, ok := m.entries[m.key(key)]
+	return ok
+}
+
+// Delete removes the entry whose identifier is equivalent to key.
+func (m *Map[V]) Delete(key string) {
+	delete(m.entries, m.key(key))
+}
+
+// Len returns the number of entries in m.
+func (m *Map[V]) Len() int {
+	return len(m.entries)
+}
+
+// Keys returns the keys, as they were last set, of each entry in m sorted by
+// their normalized identifier.
+func (m *Map[V]) Keys() []string {
+	norm := m.sorted()
+	keys := make([]string, len(norm))
+	for i, k := range norm {
+		keys[i] = m.entries[k].key
+	}
+	return keys
+}
+
+// Range calls fn for each entry of m in the order of Keys. Range stops if fn
+// returns false.
+func (m *Map[V]) Range(fn func(key string, value V) bool) {
+	for _, k := range m.sorted() {
+		e := m.entries[k]
+		if !fn(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// sorted returns the normalized identifiers of m in sorted order.
+func (m *Map[V]) sorted() []string {
+	norm := make([]string, 0, len(m.entries))
+	for k := range m.entries {
+		norm = append(norm, k)
+	}
+	sort.Strings(norm)
+	return norm
+}
@@ -0,0 +1,130 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chanced/caps"
+)
+
+func TestEqualIdent(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{"user_id", "UserID", true},
+		{"userId", "USER-ID", true},
+		{"user_id", "user.id", true},
+		{"ServeJSON", "serve_json", true},
+		{"Sha256", "sha_256", true},
+		{"user_id", "userid", false},
+		{"user_id", "user_uid", false},
+		{"\u212Aelvin", "kelvin", true},
+		{"Straße", "STRASSE", false},
+	}
+	for _, test := range tests {
+		t.Run(test.a+"__"+test.b, func(t *testing.T) {
+			if got := caps.EqualIdent(test.a, test.b); got != test.expected {
+				t.Errorf("expected EqualIdent(%q, %q) to be %v", test.a, test.b, test.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"UserID", "user_id"},
+		{"USER-ID", "user_id"},
+		{"userId", "user_id"},
+		{"marshalJSON", "marshal_json"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := caps.NormalizeKey(test.input); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+	c := caps.New(caps.Config{Replacements: []caps.Replacement{{Camel: "Oauth", Screaming: "OAUTH"}}})
+	if !c.EqualIdent("getOAUTHToken", "get_oauth_token") {
+		t.Errorf("expected getOAUTHToken and get_oauth_token to be equal")
+	}
+	if got := c.NormalizeKey("getOAUTHToken"); got != "get_oauth_token" {
+		t.Errorf("expected %q, got %q", "get_oauth_token", got)
+	}
+	if caps.EqualIdent("getOAUTHToken", "get_oauth_token") {
+		t.Errorf("expected getOAUTHToken and get_oauth_token to differ without the replacement")
+	}
+}
+
+func TestMap(t *testing.T) {
+	var m caps.Map[int]
+	m.Set("user_id", 1)
+	m.Set("UserName", 2)
+	if v, ok := m.Get("UserID"); !ok || v != 1 {
+		t.Errorf("expected 1, true; got %d, %v", v, ok)
+	}
+	if !m.Has("USER-NAME") {
+		t.Errorf("expected USER-NAME to be present")
+	}
+	m.Set("userId", 3)
+	if m.Len() != 2 {
+		t.Errorf("expected Len to be 2, got %d", m.Len())
+	}
+	if k, _ := m.Key("user_id"); k != "userId" {
+		t.Errorf("expected key to be %q, got %q", "userId", k)
+	}
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []string{"userId", "UserName"}) {
+		t.Errorf("expected [userId UserName], got %v", keys)
+	}
+	var values []int
+	m.Range(func(key string, value int) bool {
+		values = append(values, value)
+		return true
+	})
+	if !reflect.DeepEqual(values, []int{3, 2}) {
+		t.Errorf("expected [3 2], got %v", values)
+	}
+	m.Delete("user.id")
+	if m.Has("user_id") || m.Len() != 1 {
+		t.Errorf("expected user_id to be deleted")
+	}
+
+	n := caps.NewMap[string](caps.Opts{AllowedSymbols: "$"})
+	n.Set("$ref", "a")
+	if _, ok := n.Get("ref"); ok {
+		t.Errorf("expected ref to be absent")
+	}
+	if v, ok := n.Get("$ref"); !ok || v != "a" {
+		t.Errorf("expected a, true; got %q, %v", v, ok)
+	}
+}
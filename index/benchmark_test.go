@@ -0,0 +1,144 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package index_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chanced/caps/index"
+)
+
+// benchmarkWords is a deterministic set of 10,000 distinct acronyms.
+var benchmarkWords = func() []string {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	words := make([]string, 0, 10000)
+	seen := make(map[string]bool, 10000)
+	for i := 1; len(words) < 10000; i++ {
+		n := i * 7919
+		var b strings.Builder
+		for l := 3 + i%6; l > 0; l-- {
+			b.WriteByte(letters[n%26])
+			n = n/26 + i
+		}
+		if w := b.String(); !seen[w] {
+			seen[w] = true
+			words = append(words, w)
+		}
+	}
+	return words
+}()
+
+func benchmarkIndex() *index.Index {
+	idx := index.New(nil)
+	for _, w := range benchmarkWords {
+		idx.Add(strings.ToUpper(w[:1])+w[1:], strings.ToUpper(w))
+	}
+	return idx
+}
+
+var (
+	benchIndex  = benchmarkIndex()
+	benchFrozen = benchIndex.Freeze()
+	benchData   = func() []byte {
+		data, err := benchFrozen.MarshalBinary()
+		if err != nil {
+			panic(err)
+		}
+		return data
+	}()
+)
+
+func BenchmarkIndexBuild(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		benchmarkIndex()
+	}
+}
+
+func BenchmarkFrozenUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		var f index.Frozen
+		if err := f.UnmarshalBinary(benchData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFrozenThaw(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		benchFrozen.Thaw()
+	}
+}
+
+func BenchmarkIndexGet(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		if !benchIndex.Contains(benchmarkWords[n%len(benchmarkWords)]) {
+			b.Fatal("expected word to be in index")
+		}
+	}
+}
+
+func BenchmarkFrozenGet(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		if !benchFrozen.Contains(benchmarkWords[n%len(benchmarkWords)]) {
+			b.Fatal("expected word to be in index")
+		}
+	}
+}
+
+func BenchmarkIndexClone(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		benchIndex.Clone()
+	}
+}
+
+func BenchmarkIndexCopy(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		benchIndex.Copy()
+	}
+}
+
+func BenchmarkFrozenCopy(b *testing.B) {
+	b.ReportAllocs()
+	var f index.Frozen
+	for n := 0; n < b.N; n++ {
+		f = *benchFrozen
+	}
+	_ = f
+}
+
+func BenchmarkIndexFreeze(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		benchIndex.Freeze()
+	}
+}
@@ -0,0 +1,337 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package index
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/chanced/caps/token"
+)
+
+// ErrInvalidFrozen is returned by Frozen.UnmarshalBinary when the data is not
+// a valid binary encoding of a Frozen index.
+var ErrInvalidFrozen = errors.New("index: invalid frozen index encoding")
+
+const (
+	frozenMagic   = "CAPSIDX"
+	frozenVersion = 1
+)
+
+// Frozen is an immutable, compact representation of an Index. Keys are stored
+// in a sorted slice and looked up with a binary search.
+//
+// A Frozen index is cheap to copy and safe for concurrent use. It can be
+// encoded with MarshalBinary so that large sets of replacements can be loaded
+// without rebuilding the trie.
+//
+// The zero value is an empty Frozen index which uses token.DefaultCaser.
+type Frozen struct {
+	// keys are the lowercase keys of the index, sorted
+	keys []string
+	// refs are the indexes of the replacement of each key in values
+	refs []uint32
+	// values are the replacements, in the order they were first
+	// encountered
+	values []IndexedReplacement
+	caser  token.Caser
+}
+
+// NewFrozen returns an empty Frozen index which uses caser to lowercase keys.
+// It is typically used as the receiver of UnmarshalBinary.
+func NewFrozen(caser token.Caser) *Frozen {
+	return &Frozen{caser: token.CaserOrDefault(caser)}
+}
+
+// Freeze creates a Frozen copy of idx.
+func (idx *Index) Freeze() *Frozen {
+	type entry struct {
+		key   string
+		value IndexedReplacement
+	}
+	var entries []entry
+	idx.walk(nil, func(key []rune, node *Index) {
+		entries = append(entries, entry{key: string(key), value: node.value})
+	})
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	f := &Frozen{
+		keys:  make([]string, len(entries)),
+		refs:  make([]uint32, len(entries)),
+		caser: idx.caser,
+	}
+	seen := make(map[IndexedReplacement]uint32, len(entries))
+	for i, e := range entries {
+		ref, ok := seen[e.value]
+		if !ok {
+			ref = uint32(len(f.values))
+			seen[e.value] = ref
+			f.values = append(f.values, e.value)
+		}
+		f.keys[i] = e.key
+		f.refs[i] = ref
+	}
+	return f
+}
+
+// walk calls fn for each node of idx which has a value. key is the path to
+// the node and is only valid for the duration of the call.
+func (idx *Index) walk(key []rune, fn func(key []rune, node *Index)) {
+	if idx.frozen != nil {
+		idx.walkFrozen("", func(k string, value IndexedReplacement) bool {
+			fn(append(key[:len(key):len(key)], []rune(k)...), &Index{value: value})
+			return true
+		})
+		return
+	}
+	if idx.HasValue() {
+		fn(key, idx)
+	}
+	for r, node := range idx.nodes {
+		node.walk(append(key, r), fn)
+	}
+}
+
+// Index returns an Index which looks up replacements in f directly rather than
+// in a trie. Creating it is considerably cheaper than Thaw, while lookups are
+// binary searches rather than map lookups.
+//
+// The Index is converted to a trie, as with Thaw, the first time it is
+// modified (e.g. with Add). f must not be modified (e.g. with
+// UnmarshalBinary) while the Index is in use.
+func (f *Frozen) Index() *Index {
+	return &Index{
+		caser:  token.CaserOrDefault(f.caser),
+		frozen: f,
+	}
+}
+
+// seek returns the position of the first key of f which is not less than key.
+func (f *Frozen) seek(key []byte) int {
+	return sort.Search(len(f.keys), func(i int) bool { return f.keys[i] >= string(key) })
+}
+
+// Thaw creates a new, mutable, Index from f.
+func (f *Frozen) Thaw() *Index {
+	idx := New(f.caser)
+	for i, key := range f.keys {
+		node := idx
+		for _, r := range key {
+			next, ok := node.nodes[r]
+			if !ok {
+				next = &Index{
+					nodes: make(map[rune]*Index),
+					caser: idx.caser,
+				}
+				node.nodes[r] = next
+			}
+			node = next
+		}
+		node.value = f.values[f.refs[i]]
+	}
+	return idx
+}
+
+func (f *Frozen) lower(s string) string {
+	return token.ToLower(token.CaserOrDefault(f.caser), s)
+}
+
+// search returns the position of key in f.keys or -1 if f does not contain
+// key. key must already be lowercase.
+func (f *Frozen) search(key string) int {
+	i := sort.SearchStrings(f.keys, key)
+	if i < len(f.keys) && f.keys[i] == key {
+		return i
+	}
+	return -1
+}
+
+// Get returns the IndexedReplacement indexed by s and true if found.
+func (f *Frozen) Get(s string) (IndexedReplacement, bool) {
+	if len(s) == 0 {
+		return IndexedReplacement{}, false
+	}
+	i := f.search(f.lower(s))
+	if i < 0 {
+		return IndexedReplacement{}, false
+	}
+	return f.values[f.refs[i]], true
+}
+
+// Contains reports whether f contains a replacement indexed by s.
+func (f *Frozen) Contains(s string) bool {
+	_, ok := f.Get(s)
+	return ok
+}
+
+// HasPrefix reports whether any key of f begins with prefix.
+func (f *Frozen) HasPrefix(prefix string) bool {
+	prefix = f.lower(prefix)
+	i := sort.SearchStrings(f.keys, prefix)
+	return i < len(f.keys) && strings.HasPrefix(f.keys[i], prefix)
+}
+
+// Len returns the number of IndexedReplacements in f.
+func (f *Frozen) Len() int {
+	return len(f.values)
+}
+
+// Values returns all IndexedReplacements in f, sorted by Lower.
+func (f *Frozen) Values() []IndexedReplacement {
+	values := make([]IndexedReplacement, len(f.values))
+	copy(values, f.values)
+	sort.Slice(values, func(i, j int) bool { return values[i].Lower < values[j].Lower })
+	return values
+}
+
+// MarshalBinary encodes f into a binary form.
+//
+// The Caser of f is not encoded.
+func (f *Frozen) MarshalBinary() ([]byte, error) {
+	size := len(frozenMagic) + 1 + 2*binary.MaxVarintLen64
+	for _, v := range f.values {
+		size += len(v.Camel) + len(v.Screaming) + len(v.Lower) + 3*binary.MaxVarintLen32
+	}
+	for _, k := range f.keys {
+		size += len(k) + 2*binary.MaxVarintLen32
+	}
+	b := make([]byte, 0, size)
+	b = append(b, frozenMagic...)
+	b = append(b, frozenVersion)
+	b = appendUvarint(b, uint64(len(f.values)))
+	for _, v := range f.values {
+		b = appendString(b, v.Camel)
+		b = appendString(b, v.Screaming)
+		b = appendString(b, v.Lower)
+	}
+	b = appendUvarint(b, uint64(len(f.keys)))
+	for i, k := range f.keys {
+		b = appendString(b, k)
+		b = appendUvarint(b, uint64(f.refs[i]))
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes data, produced by MarshalBinary, into f.
+//
+// If f was created with NewFrozen, its Caser is retained. Otherwise,
+// token.DefaultCaser is used.
+func (f *Frozen) UnmarshalBinary(data []byte) error {
+	if !strings.HasPrefix(string(data), frozenMagic) || len(data) < len(frozenMagic)+1 {
+		return ErrInvalidFrozen
+	}
+	if data[len(frozenMagic)] != frozenVersion {
+		return ErrInvalidFrozen
+	}
+	// copying data into a single string allows each key and value to be a
+	// substring of it rather than a separate allocation
+	d := decoder{data: string(data[len(frozenMagic)+1:])}
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		return ErrInvalidFrozen
+	}
+	values := make([]IndexedReplacement, n)
+	for i := range values {
+		values[i] = IndexedReplacement{
+			Camel:     d.string(),
+			Screaming: d.string(),
+			Lower:     d.string(),
+		}
+	}
+	n = d.uvarint()
+	if n > uint64(len(d.data)) {
+		return ErrInvalidFrozen
+	}
+	keys := make([]string, n)
+	refs := make([]uint32, n)
+	for i := range keys {
+		keys[i] = d.string()
+		ref := d.uvarint()
+		if ref >= uint64(len(values)) {
+			return ErrInvalidFrozen
+		}
+		refs[i] = uint32(ref)
+		if i > 0 && keys[i-1] >= keys[i] {
+			return ErrInvalidFrozen
+		}
+	}
+	if d.err || len(d.data) != 0 {
+		return ErrInvalidFrozen
+	}
+	f.keys, f.refs, f.values = keys, refs, values
+	f.caser = token.CaserOrDefault(f.caser)
+	return nil
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+type decoder struct {
+	data string
+	err  bool
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err {
+		return 0
+	}
+	var v uint64
+	var n int
+	for i := 0; i < len(d.data) && i < binary.MaxVarintLen64; i++ {
+		b := d.data[i]
+		v |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			n = i + 1
+			break
+		}
+	}
+	if n <= 0 {
+		d.err = true
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.uvarint()
+	if d.err || n > uint64(len(d.data)) {
+		d.err = true
+		return ""
+	}
+	s := d.data[:n]
+	d.data = d.data[n:]
+	return s
+}
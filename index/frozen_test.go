@@ -0,0 +1,206 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package index_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/chanced/caps/index"
+	"github.com/chanced/caps/token"
+)
+
+func newTestIndex() *index.Index {
+	idx := index.New(nil)
+	idx.Add("Json", "JSON")
+	idx.Add("Jsonp", "JSONP")
+	idx.Add("Http", "HTTP")
+	idx.Add("Ipv4", "IPv4")
+	idx.Add("Über", "ÜBER")
+	idx.Add("Смс", "СМС")
+	return idx
+}
+
+func TestFreeze(t *testing.T) {
+	idx := newTestIndex()
+	f := idx.Freeze()
+	if f.Len() != 6 {
+		t.Errorf("expected Len to be 6, got %d", f.Len())
+	}
+	for _, key := range []string{"json", "JSON", "Jsonp", "http", "IPV4", "ipv4", "über", "ÜBER", "смс"} {
+		expected, _ := idx.Get(key)
+		got, ok := f.Get(key)
+		if !ok {
+			t.Errorf("expected %q to be in the frozen index", key)
+		}
+		if got != expected {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+	for _, key := range []string{"", "js", "jsonpp", "ht"} {
+		if f.Contains(key) {
+			t.Errorf("expected %q to not be in the frozen index", key)
+		}
+	}
+	if !f.HasPrefix("js") || !f.HasPrefix("JSONP") || f.HasPrefix("xml") {
+		t.Error("unexpected HasPrefix result")
+	}
+	values := f.Values()
+	lowers := make([]string, len(values))
+	for i, v := range values {
+		lowers[i] = v.Lower
+	}
+	if !reflect.DeepEqual(lowers, []string{"http", "ipv4", "json", "jsonp", "über", "смс"}) {
+		t.Errorf("unexpected values: %v", lowers)
+	}
+}
+
+func TestThaw(t *testing.T) {
+	idx := newTestIndex()
+	thawed := idx.Freeze().Thaw()
+	for _, v := range idx.Values() {
+		for _, key := range []string{v.Camel, v.Screaming} {
+			got, ok := thawed.Get(key)
+			if !ok || got != v {
+				t.Errorf("expected %v for %q, got %v", v, key, got)
+			}
+		}
+	}
+	if m, ok := thawed.Match("jso"); !ok || !m.HasPartialMatches() {
+		t.Error("expected a partial match for jso")
+	}
+	thawed.Add("Xml", "XML")
+	if idx.Contains("xml") {
+		t.Error("expected the thawed index to be independent")
+	}
+}
+
+func TestFrozenIndex(t *testing.T) {
+	trie := newTestIndex()
+	f := trie.Freeze()
+	idx := f.Index()
+	for _, key := range []string{"json", "JSONP", "http", "IPV4", "über", "смс", "", "js", "jsonpp"} {
+		expected, expectedOK := trie.Get(key)
+		got, ok := idx.Get(key)
+		if got != expected || ok != expectedOK {
+			t.Errorf("Get(%q): expected %v, %t, got %v, %t", key, expected, expectedOK, got, ok)
+		}
+	}
+	for _, keys := range [][]string{{"j", "s", "o", "n"}, {"j", "s", "o", "n", "p"}, {"j", "s", "x"}, {"h", "t"}, {"ipv", "4"}, {"x"}} {
+		expected, m := trie.Clone(), idx.Clone()
+		for _, key := range keys {
+			var expectedOK, ok bool
+			expected, expectedOK = expected.Match(key)
+			m, ok = m.Match(key)
+			if ok != expectedOK || m.HasMatched() != expected.HasMatched() || m.LastMatch() != expected.LastMatch() ||
+				m.PartialMatches() != expected.PartialMatches() || m.HasValue() != expected.HasValue() {
+				t.Errorf("Match(%q) of %v: expected %+v, %t, got %+v, %t", key, keys, expected, expectedOK, m, ok)
+			}
+		}
+	}
+	if !reflect.DeepEqual(idx.Values(), trie.Values()) {
+		t.Errorf("expected %v, got %v", trie.Values(), idx.Values())
+	}
+	if idx.Len() != trie.Len() {
+		t.Errorf("expected Len to be %d, got %d", trie.Len(), idx.Len())
+	}
+	expectedNode, _ := trie.Match("js")
+	node, _ := idx.Match("js")
+	if node.Len() != expectedNode.Len() {
+		t.Errorf("expected Len of the js node to be %d, got %d", expectedNode.Len(), node.Len())
+	}
+	if !reflect.DeepEqual(idx.WithPrefix("JS"), trie.WithPrefix("JS")) {
+		t.Errorf("expected %v, got %v", trie.WithPrefix("JS"), idx.WithPrefix("JS"))
+	}
+	var expectedKeys, keys []string
+	trie.Walk(func(key string, _ index.IndexedReplacement) bool {
+		expectedKeys = append(expectedKeys, key)
+		return true
+	})
+	idx.Walk(func(key string, _ index.IndexedReplacement) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Errorf("expected keys %v, got %v", expectedKeys, keys)
+	}
+	if !reflect.DeepEqual(idx.Freeze().Values(), f.Values()) {
+		t.Errorf("expected the refrozen index to equal the frozen index")
+	}
+
+	idx.Add("Xml", "XML")
+	idx.Delete("Http")
+	if !idx.Contains("xml") || idx.Contains("http") || !idx.Contains("json") {
+		t.Error("expected the index to be modified")
+	}
+	if f.Contains("xml") || !f.Contains("http") {
+		t.Error("expected the frozen index to be unchanged")
+	}
+}
+
+func TestFrozenBinary(t *testing.T) {
+	f := newTestIndex().Freeze()
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded index.Frozen
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), f.Values()) {
+		t.Errorf("expected %v, got %v", f.Values(), decoded.Values())
+	}
+	if v, ok := decoded.Get("IPV4"); !ok || v.Screaming != "IPv4" {
+		t.Errorf("expected IPv4, got %v", v)
+	}
+
+	custom := index.NewFrozen(token.TurkishCaser)
+	if err := custom.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !custom.Contains("JSON") {
+		t.Error("expected JSON to be in the decoded index")
+	}
+
+	var empty index.Frozen
+	data, err = empty.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Len() != 0 || decoded.Contains("json") {
+		t.Error("expected the decoded index to be empty")
+	}
+
+	for _, invalid := range [][]byte{nil, []byte("CAPSIDX"), []byte("CAPSIDX\x02"), data[:len(data)-1], append(data, 0)} {
+		if err := decoded.UnmarshalBinary(invalid); !errors.Is(err, index.ErrInvalidFrozen) {
+			t.Errorf("expected ErrInvalidFrozen for %q, got %v", invalid, err)
+		}
+	}
+}
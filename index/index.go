@@ -26,6 +26,10 @@
 package index
 
 import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/chanced/caps/token"
 )
 
@@ -53,6 +57,11 @@ type Index struct {
 	lastMatch      IndexedReplacement
 	partialMatches []rune
 	caser          token.Caser
+	// frozen is set if the Index looks up replacements in a Frozen index
+	// rather than in nodes (see Frozen.Index). prefix is then the lowercase
+	// key of the node.
+	frozen *Frozen
+	prefix string
 }
 
 // Clone creates a copy of the Index
@@ -63,7 +72,31 @@ func (idx *Index) Clone() Index {
 		lastMatch:      idx.lastMatch,
 		partialMatches: idx.partialMatches,
 		caser:          idx.caser,
+		frozen:         idx.frozen,
+		prefix:         idx.prefix,
+	}
+}
+
+// Copy creates a deep copy of the Index. Unlike Clone, the nodes of the copy
+// are not shared, so the copy can be modified without affecting idx.
+func (idx *Index) Copy() *Index {
+	cp := &Index{
+		value:     idx.value,
+		nodes:     make(map[rune]*Index, len(idx.nodes)),
+		lastMatch: idx.lastMatch,
+		caser:     idx.caser,
+		// a Frozen index is immutable and is converted to nodes before the
+		// Index is modified
+		frozen: idx.frozen,
+		prefix: idx.prefix,
+	}
+	if idx.partialMatches != nil {
+		cp.partialMatches = append(make([]rune, 0, len(idx.partialMatches)), idx.partialMatches...)
+	}
+	for r, node := range idx.nodes {
+		cp.nodes[r] = node.Copy()
 	}
+	return cp
 }
 
 // NewIndex creates a new Index of Replacements,
@@ -123,8 +156,13 @@ func (idx Index) Match(s string) (Index, bool) {
 		return idx, false
 	}
 
+	if idx.frozen != nil {
+		return idx.matchFrozen(s)
+	}
 	next := &idx
-	for _, r := range token.ToLower(idx.caser, s) {
+	caser := token.CaserOrDefault(idx.caser)
+	for _, r := range s {
+		r = caser.ToLower(r)
 		if next, ok = next.nodes[r]; !ok || next == nil {
 			return Index{
 				partialMatches: idx.partialMatches,
@@ -152,6 +190,45 @@ func (idx Index) Match(s string) (Index, bool) {
 	return idx, true
 }
 
+// matchFrozen is the equivalent of Match for an Index backed by a Frozen
+// index. A node exists for each prefix of the keys of the Frozen index.
+func (idx Index) matchFrozen(s string) (Index, bool) {
+	f := idx.frozen
+	caser := token.CaserOrDefault(idx.caser)
+	var buf [32]byte
+	key := append(buf[:0], idx.prefix...)
+	for _, r := range s {
+		r = caser.ToLower(r)
+		key = utf8.AppendRune(key, r)
+		i := f.seek(key)
+		if i == len(f.keys) || len(f.keys[i]) < len(key) || f.keys[i][:len(key)] != string(key) {
+			return Index{
+				partialMatches: idx.partialMatches,
+				lastMatch:      idx.lastMatch,
+				caser:          idx.caser,
+			}, false
+		}
+		idx = Index{
+			lastMatch:      idx.lastMatch,
+			partialMatches: idx.partialMatches,
+			caser:          idx.caser,
+			frozen:         f,
+		}
+		if len(f.keys[i]) == len(key) {
+			idx.value = f.values[f.refs[i]]
+			idx.lastMatch = idx.value
+			idx.partialMatches = nil
+		} else {
+			if idx.partialMatches == nil {
+				idx.partialMatches = make([]rune, 0, 8)
+			}
+			idx.partialMatches = append(idx.partialMatches, r)
+		}
+	}
+	idx.prefix = string(key)
+	return idx, true
+}
+
 // Get searches the index for the t, returning the IndexedReplacement and true
 // if found.
 //
@@ -160,18 +237,40 @@ func (idx *Index) Get(s string) (IndexedReplacement, bool) {
 	if len(s) == 0 {
 		return idx.value, idx.value.HasValue()
 	}
+	if idx.frozen != nil {
+		return idx.getFrozen(s)
+	}
 	node := idx
 	var ok bool
-	for _, r := range token.ToLower(idx.caser, s) {
-		if node, ok = node.nodes[r]; !ok {
+	caser := token.CaserOrDefault(idx.caser)
+	for _, r := range s {
+		if node, ok = node.nodes[caser.ToLower(r)]; !ok {
 			return IndexedReplacement{}, false
 		}
 	}
 	return node.value, node.value.HasValue()
 }
 
+// getFrozen is the equivalent of Get for an Index backed by a Frozen index.
+func (idx *Index) getFrozen(s string) (IndexedReplacement, bool) {
+	f := idx.frozen
+	caser := token.CaserOrDefault(idx.caser)
+	var buf [32]byte
+	key := append(buf[:0], idx.prefix...)
+	for _, r := range s {
+		key = utf8.AppendRune(key, caser.ToLower(r))
+	}
+	if i := f.seek(key); i < len(f.keys) && f.keys[i] == string(key) {
+		return f.values[f.refs[i]], true
+	}
+	return IndexedReplacement{}, false
+}
+
 // Nodes returns all nodes in the Index
 func (idx *Index) Nodes() []Index {
+	if idx.frozen != nil {
+		return idx.thawed().Nodes()
+	}
 	nodes := make([]Index, 0, len(idx.nodes))
 	nodes = append(nodes, *idx)
 	for _, node := range idx.nodes {
@@ -180,18 +279,170 @@ func (idx *Index) Nodes() []Index {
 	return nodes
 }
 
-// Values returns all IndexedReplacements in the Index
+// Values returns all IndexedReplacements in the Index, sorted by Lower.
 func (idx *Index) Values() []IndexedReplacement {
-	nodes := idx.Nodes()
-	values := make([]IndexedReplacement, 0, len(nodes))
-	for _, n := range nodes {
-		if n.HasValue() {
-			values = append(values, n.value)
+	if idx.frozen != nil {
+		return idx.collectFrozen("")
+	}
+	return idx.collect(nil)
+}
+
+// Len returns the number of IndexedReplacements in the Index.
+func (idx *Index) Len() int {
+	if idx.frozen != nil && len(idx.prefix) == 0 {
+		return idx.frozen.Len()
+	}
+	seen := make(map[IndexedReplacement]struct{})
+	if idx.frozen != nil {
+		idx.walkFrozen("", func(_ string, value IndexedReplacement) bool {
+			seen[value] = struct{}{}
+			return true
+		})
+		return len(seen)
+	}
+	idx.count(seen)
+	return len(seen)
+}
+
+// count adds the value of idx and of each of its descendants to seen.
+func (idx *Index) count(seen map[IndexedReplacement]struct{}) {
+	if idx.HasValue() {
+		seen[idx.value] = struct{}{}
+	}
+	for _, node := range idx.nodes {
+		node.count(seen)
+	}
+}
+
+// WithPrefix returns the IndexedReplacements which are indexed by a key
+// beginning with prefix, sorted by Lower.
+//
+// The comparison is case-insensitive. If prefix is empty, all
+// IndexedReplacements are returned.
+func (idx *Index) WithPrefix(prefix string) []IndexedReplacement {
+	if idx.frozen != nil {
+		return idx.collectFrozen(token.ToLower(idx.caser, prefix))
+	}
+	node := idx
+	var ok bool
+	key := []rune(token.ToLower(idx.caser, prefix))
+	for _, r := range key {
+		if node, ok = node.nodes[r]; !ok {
+			return nil
+		}
+	}
+	return node.collect(key)
+}
+
+// Walk calls fn for each key of the Index and the IndexedReplacement it
+// indexes, in sorted order of the keys. Keys are lowercase. An
+// IndexedReplacement whose Camel and Screaming forms differ in more than case
+// is visited once for each.
+//
+// Walk stops if fn returns false.
+func (idx *Index) Walk(fn func(key string, value IndexedReplacement) bool) {
+	if idx.frozen != nil {
+		idx.walkFrozen("", fn)
+		return
+	}
+	idx.walkSorted(nil, func(key []rune, node *Index) bool {
+		return fn(string(key), node.value)
+	})
+}
+
+// collect returns the distinct values of idx, sorted by Lower, where idx is
+// the node of prefix.
+func (idx *Index) collect(prefix []rune) []IndexedReplacement {
+	var values []IndexedReplacement
+	seen := make(map[IndexedReplacement]struct{})
+	idx.walkSorted(prefix, func(_ []rune, node *Index) bool {
+		if _, ok := seen[node.value]; !ok {
+			seen[node.value] = struct{}{}
+			values = append(values, node.value)
+		}
+		return true
+	})
+	sort.Slice(values, func(i, j int) bool { return values[i].Lower < values[j].Lower })
+	return values
+}
+
+// walkFrozen calls fn for each key of the Frozen index of idx which begins
+// with the prefix of idx followed by prefix, in sorted order, and stops if fn
+// returns false. The prefix of idx is removed from the keys passed to fn.
+func (idx *Index) walkFrozen(prefix string, fn func(key string, value IndexedReplacement) bool) {
+	f := idx.frozen
+	full := idx.prefix + prefix
+	for i := sort.SearchStrings(f.keys, full); i < len(f.keys) && strings.HasPrefix(f.keys[i], full); i++ {
+		if !fn(f.keys[i][len(idx.prefix):], f.values[f.refs[i]]) {
+			return
 		}
 	}
+}
+
+// collectFrozen is the equivalent of collect for an Index backed by a Frozen
+// index.
+func (idx *Index) collectFrozen(prefix string) []IndexedReplacement {
+	var values []IndexedReplacement
+	seen := make(map[IndexedReplacement]struct{})
+	idx.walkFrozen(prefix, func(_ string, value IndexedReplacement) bool {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			values = append(values, value)
+		}
+		return true
+	})
+	sort.Slice(values, func(i, j int) bool { return values[i].Lower < values[j].Lower })
 	return values
 }
 
+// thawed returns idx or, if idx is backed by a Frozen index, the equivalent
+// node of a thawed copy of it.
+func (idx *Index) thawed() *Index {
+	if idx.frozen == nil {
+		return idx
+	}
+	node := idx.frozen.Thaw()
+	node.caser = idx.caser
+	for _, r := range idx.prefix {
+		next, ok := node.nodes[r]
+		if !ok {
+			return New(idx.caser)
+		}
+		node = next
+	}
+	return node
+}
+
+// thaw converts idx into a trie if it is backed by a Frozen index so that it
+// can be modified.
+func (idx *Index) thaw() {
+	if idx.frozen == nil {
+		return
+	}
+	node := idx.thawed()
+	idx.nodes, idx.value = node.nodes, node.value
+	idx.frozen, idx.prefix = nil, ""
+}
+
+// walkSorted is like walk but visits the nodes in sorted order of their keys
+// and stops if fn returns false.
+func (idx *Index) walkSorted(key []rune, fn func(key []rune, node *Index) bool) bool {
+	if idx.HasValue() && !fn(key, idx) {
+		return false
+	}
+	runes := make([]rune, 0, len(idx.nodes))
+	for r := range idx.nodes {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	for _, r := range runes {
+		if !idx.nodes[r].walkSorted(append(key[:len(key):len(key)], r), fn) {
+			return false
+		}
+	}
+	return true
+}
+
 // Add inserts r into the Index, indexed by the lowercase variant of r.Camel AND
 // r.Screaming.
 //
@@ -202,6 +453,7 @@ func (idx *Index) Values() []IndexedReplacement {
 // If idx.IsReversed is true, the IndexedReplacement is inserted into the
 // Index with the key in reverse order (e.g. AnExample -> elpmaxena).
 func (idx *Index) Add(camel string, screaming string) bool {
+	idx.thaw()
 	ir := IndexedReplacement{
 		Screaming: screaming,
 		Camel:     camel,
@@ -251,35 +503,47 @@ func (idx *Index) Add(camel string, screaming string) bool {
 	return exists
 }
 
-// Delete deletes the IndexedReplacement indexed by key from the Index.
+// Delete deletes the IndexedReplacement indexed by key from the Index,
+// including the key of its other variant (Camel or Screaming), and reports
+// whether the Index contained key.
 func (idx *Index) Delete(key string) bool {
-	node := idx
-
-	nodes := make([]*Index, len(key))
-	var ok bool
-	var i int
-	var r rune
+	idx.thaw()
+	lower := token.ToLower(idx.caser, key)
+	value, ok := idx.Get(lower)
+	if !ok {
+		return false
+	}
+	idx.remove(lower)
+	for _, k := range [...]string{value.Lower, token.ToLower(idx.caser, value.Screaming)} {
+		if k == lower {
+			continue
+		}
+		if v, ok := idx.Get(k); ok && v == value {
+			idx.remove(k)
+		}
+	}
+	return true
+}
 
-	for i, r = range token.ToLower(idx.caser, key) {
-		nodes[i] = node
-		if node, ok = node.nodes[r]; !ok || node == nil {
-			return false
+// remove clears the value of the node of the lowercase key and prunes the
+// nodes of key which no longer lead to a value.
+func (idx *Index) remove(key string) {
+	runes := []rune(key)
+	// path[i] is the node of runes[:i]
+	path := make([]*Index, len(runes)+1)
+	path[0] = idx
+	for i, r := range runes {
+		next, ok := path[i].nodes[r]
+		if !ok || next == nil {
+			return
 		}
+		path[i+1] = next
 	}
-	node.value = IndexedReplacement{}
-
-	child := node
-	k := []rune(key)
-	for i := len(key) - 1; i >= 0; i-- {
-		r = k[i]
-		child = node
-		node = nodes[i]
-		if child.value.IsEmpty() && len(child.nodes) == 0 {
-			// safe to delete
-			delete(node.nodes, r)
-		} else {
+	path[len(runes)].value = IndexedReplacement{}
+	for i := len(runes); i > 0; i-- {
+		if path[i].HasValue() || len(path[i].nodes) > 0 {
 			break
 		}
+		delete(path[i-1].nodes, runes[i-1])
 	}
-	return true
 }
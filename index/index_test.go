@@ -25,6 +25,8 @@
 package index_test
 
 import (
+	"math/rand"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -60,6 +62,28 @@ func TestClone(t *testing.T) {
 	}
 }
 
+func TestCopy(t *testing.T) {
+	idx := index.New(nil)
+	idx.Add("Abcd", "ABCD")
+	idx.Add("Ab", "AB")
+
+	cp := idx.Copy()
+	if !cp.Contains("Abcd") || !cp.Contains("Ab") {
+		t.Error("expected copy to contain Abcd and Ab")
+	}
+	cp.Delete("Abcd")
+	cp.Add("Xyz", "XYZ")
+	if !idx.Contains("Abcd") {
+		t.Error("expected idx to still contain Abcd")
+	}
+	if idx.Contains("Xyz") {
+		t.Error("expected idx to not contain Xyz")
+	}
+	if cp.Contains("Abcd") || !cp.Contains("Xyz") {
+		t.Error("expected copy to contain Xyz but not Abcd")
+	}
+}
+
 func TestValues(t *testing.T) {
 	idx := index.New(nil)
 	idx.Add("Cat", "CAT")
@@ -267,3 +291,183 @@ func TestPartialMatches(t *testing.T) {
 		t.Error("expected abc, got", merged)
 	}
 }
+
+func TestWithPrefix(t *testing.T) {
+	idx := index.New(nil)
+	idx.Add("Http", "HTTP")
+	idx.Add("Https", "HTTPS")
+	idx.Add("Html", "HTML")
+	idx.Add("Id", "ID")
+	idx.Add("Über", "ÜBER")
+
+	tests := []struct {
+		prefix   string
+		expected []string
+	}{
+		{"ht", []string{"HTML", "HTTP", "HTTPS"}},
+		{"HTTP", []string{"HTTP", "HTTPS"}},
+		{"Https", []string{"HTTPS"}},
+		{"httpx", nil},
+		{"üb", []string{"ÜBER"}},
+		{"", []string{"HTML", "HTTP", "HTTPS", "ID", "ÜBER"}},
+	}
+	for _, test := range tests {
+		t.Run(test.prefix, func(t *testing.T) {
+			var got []string
+			for _, v := range idx.WithPrefix(test.prefix) {
+				got = append(got, v.Screaming)
+			}
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestWalk(t *testing.T) {
+	idx := index.New(nil)
+	idx.Add("Uuid", "UUID")
+	idx.Add("Id", "ID")
+	idx.Add("Json", "JSON")
+	idx.Add("Abcd", "ABCD2")
+
+	var keys []string
+	idx.Walk(func(key string, value index.IndexedReplacement) bool {
+		keys = append(keys, key)
+		if v, _ := idx.Get(key); v != value {
+			t.Errorf("expected %v for %q, got %v", v, key, value)
+		}
+		return true
+	})
+	expected := []string{"abcd", "abcd2", "id", "json", "uuid"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %q, got %q", expected, keys)
+	}
+
+	keys = nil
+	idx.Walk(func(key string, _ index.IndexedReplacement) bool {
+		keys = append(keys, key)
+		return len(keys) < 2
+	})
+	if !reflect.DeepEqual(keys, expected[:2]) {
+		t.Errorf("expected Walk to stop after %q, got %q", expected[:2], keys)
+	}
+
+	if idx.Len() != 4 {
+		t.Errorf("expected Len to be 4, got %d", idx.Len())
+	}
+	if n := index.New(nil).Len(); n != 0 {
+		t.Errorf("expected Len of an empty Index to be 0, got %d", n)
+	}
+	var lowers []string
+	for _, v := range idx.Values() {
+		lowers = append(lowers, v.Lower)
+	}
+	if expected := []string{"abcd", "id", "json", "uuid"}; !reflect.DeepEqual(lowers, expected) {
+		t.Errorf("expected Values to be %q, got %q", expected, lowers)
+	}
+}
+
+func TestDeleteMultiByte(t *testing.T) {
+	idx := index.New(nil)
+	idx.Add("Über", "ÜBER")
+	idx.Add("Überall", "ÜBERALL")
+	idx.Add("Смс", "СМС")
+
+	if !idx.Delete("ÜBER") {
+		t.Error("expected ÜBER to be deleted")
+	}
+	if idx.Contains("über") {
+		t.Error("expected über to have been deleted")
+	}
+	if !idx.Contains("überall") {
+		t.Error("expected überall to be in index")
+	}
+	if idx.Delete("Über") {
+		t.Error("expected Über to have already been deleted")
+	}
+	if !idx.Delete("смс") {
+		t.Error("expected смс to be deleted")
+	}
+	if !idx.Delete("Überall") {
+		t.Error("expected Überall to be deleted")
+	}
+	if n := len(idx.Nodes()); n != 1 {
+		t.Errorf("expected only the root node to remain, got %d nodes", n)
+	}
+}
+
+// TestDeleteRandom applies random sequences of Add and Delete to an Index and
+// compares the result with a reference map.
+func TestDeleteRandom(t *testing.T) {
+	replacements := [][2]string{
+		{"Über", "ÜBER"},
+		{"Überall", "ÜBERALL"},
+		{"Ü", "Ü"},
+		{"Смс", "СМС"},
+		{"См", "СМ"},
+		{"Смсц", "СМСЦ"},
+		{"Αβγ", "ΑΒΓ"},
+		{"Ip", "IP"},
+		{"Ipv4", "IPv4"},
+		{"Uid", "UUID"},
+		{"Uuid", "UUID"},
+		{"Çağ", "ÇAĞ"},
+		{"日本", "日本語"},
+	}
+	lower := func(s string) string { return token.ToLower(nil, s) }
+
+	rnd := rand.New(rand.NewSource(1))
+	for run := 0; run < 200; run++ {
+		idx := index.New(nil)
+		ref := map[string]index.IndexedReplacement{}
+		remove := func(key string) bool {
+			v, ok := ref[key]
+			if !ok {
+				return false
+			}
+			for k, rv := range ref {
+				if rv == v {
+					delete(ref, k)
+				}
+			}
+			return true
+		}
+		for op := 0; op < 50; op++ {
+			rep := replacements[rnd.Intn(len(replacements))]
+			if rnd.Intn(3) > 0 {
+				idx.Add(rep[0], rep[1])
+				remove(lower(rep[0]))
+				remove(lower(rep[1]))
+				v := index.IndexedReplacement{Camel: rep[0], Screaming: rep[1], Lower: lower(rep[0])}
+				ref[lower(rep[0])] = v
+				ref[lower(rep[1])] = v
+				continue
+			}
+			key := rep[rnd.Intn(2)]
+			if expected, got := remove(lower(key)), idx.Delete(key); expected != got {
+				t.Fatalf("run %d op %d: expected Delete(%q) to return %t, got %t", run, op, key, expected, got)
+			}
+		}
+
+		got := map[string]index.IndexedReplacement{}
+		idx.Walk(func(key string, value index.IndexedReplacement) bool {
+			got[key] = value
+			return true
+		})
+		if !reflect.DeepEqual(got, ref) {
+			t.Fatalf("run %d: expected %v, got %v", run, ref, got)
+		}
+		// every leaf must lead to a value
+		prefixes := map[string]bool{"": true}
+		for k := range ref {
+			r := []rune(k)
+			for i := 1; i <= len(r); i++ {
+				prefixes[string(r[:i])] = true
+			}
+		}
+		if n := len(idx.Nodes()); n != len(prefixes) {
+			t.Fatalf("run %d: expected %d nodes, got %d", run, len(prefixes), n)
+		}
+	}
+}
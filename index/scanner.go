@@ -0,0 +1,206 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package index
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/chanced/caps/token"
+)
+
+// DefaultScannerMinLen is the default minimum length, in runes, of the keys
+// a Scanner searches for. Shorter keys (e.g. "id") are too likely to occur by
+// chance within other words (e.g. "video").
+const DefaultScannerMinLen = 4
+
+// Occurrence is a match of a key of an Index found by a Scanner.
+type Occurrence struct {
+	// Start is the byte offset of the beginning of the match.
+	Start int
+	// End is the byte offset of the end of the match.
+	End int
+	// Replacement is the IndexedReplacement of the matched key.
+	Replacement IndexedReplacement
+}
+
+// Scanner finds occurrences of the keys of an Index within text using the
+// Aho–Corasick algorithm. The text is compared with the lowercase keys of the
+// Index rune by rune after lowering.
+//
+// A Scanner is immutable and safe for concurrent use. It does not reflect
+// changes made to the Index after it was created.
+type Scanner struct {
+	nodes []scanNode
+	caser token.Caser
+}
+
+type scanNode struct {
+	next map[rune]int32
+	// fail is the node of the longest proper suffix of this node's path
+	// which is also a path in the trie
+	fail int32
+	// output is the nearest node, following fail links and including this
+	// node, which is the end of a key. It is -1 if there is none.
+	output int32
+	// depth is the length, in runes, of the path to this node
+	depth int
+	value IndexedReplacement
+}
+
+// NewScanner creates a Scanner which finds the keys of idx with a length of
+// at least minLen runes. If minLen is less than 1, DefaultScannerMinLen is
+// used.
+func NewScanner(idx *Index, minLen int) *Scanner {
+	if minLen < 1 {
+		minLen = DefaultScannerMinLen
+	}
+	s := &Scanner{
+		nodes: []scanNode{{next: make(map[rune]int32), output: -1}},
+		caser: idx.caser,
+	}
+	idx.walk(nil, func(key []rune, node *Index) {
+		if len(key) < minLen {
+			return
+		}
+		cur := int32(0)
+		for _, r := range key {
+			n, ok := s.nodes[cur].next[r]
+			if !ok {
+				n = int32(len(s.nodes))
+				s.nodes = append(s.nodes, scanNode{
+					next:   make(map[rune]int32),
+					output: -1,
+					depth:  s.nodes[cur].depth + 1,
+				})
+				s.nodes[cur].next[r] = n
+			}
+			cur = n
+		}
+		s.nodes[cur].value = node.value
+		s.nodes[cur].output = cur
+	})
+	s.link()
+	return s
+}
+
+// link computes the failure and output links of each node in breadth-first
+// order.
+func (s *Scanner) link() {
+	queue := make([]int32, 0, len(s.nodes))
+	for _, n := range s.nodes[0].next {
+		queue = append(queue, n)
+	}
+	// sorting is not required for correctness but keeps construction
+	// deterministic
+	sort.Slice(queue, func(i, j int) bool { return queue[i] < queue[j] })
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range s.nodes[cur].next {
+			queue = append(queue, child)
+			f := s.nodes[cur].fail
+			for {
+				if n, ok := s.nodes[f].next[r]; ok && n != child {
+					s.nodes[child].fail = n
+					break
+				}
+				if f == 0 {
+					s.nodes[child].fail = 0
+					break
+				}
+				f = s.nodes[f].fail
+			}
+			if s.nodes[child].output < 0 {
+				s.nodes[child].output = s.nodes[s.nodes[child].fail].output
+			}
+		}
+	}
+}
+
+// FindAll returns all, possibly overlapping, occurrences of keys within text
+// ordered by their end and then by length, longest first.
+func (s *Scanner) FindAll(text string) []Occurrence {
+	if len(s.nodes) == 1 || len(text) == 0 {
+		return nil
+	}
+	caser := token.CaserOrDefault(s.caser)
+	var res []Occurrence
+	// starts contains the byte offset of each rune of text
+	starts := make([]int, 0, len(text))
+	cur := int32(0)
+	for i, r := range text {
+		starts = append(starts, i)
+		end := i + utf8.RuneLen(r)
+		if r == utf8.RuneError {
+			_, w := utf8.DecodeRuneInString(text[i:])
+			end = i + w
+		}
+		r = caser.ToLower(r)
+		for {
+			if n, ok := s.nodes[cur].next[r]; ok {
+				cur = n
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = s.nodes[cur].fail
+		}
+		for o := s.nodes[cur].output; o >= 0; o = s.nodes[s.nodes[o].fail].output {
+			node := s.nodes[o]
+			res = append(res, Occurrence{
+				Start:       starts[len(starts)-node.depth],
+				End:         end,
+				Replacement: node.value,
+			})
+		}
+	}
+	return res
+}
+
+// FindLongest returns the leftmost-longest, non-overlapping, occurrences of
+// keys within text ordered by their position.
+func (s *Scanner) FindLongest(text string) []Occurrence {
+	all := s.FindAll(text)
+	if len(all) == 0 {
+		return nil
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].Start != all[j].Start {
+			return all[i].Start < all[j].Start
+		}
+		return all[i].End > all[j].End
+	})
+	res := all[:0]
+	end := 0
+	for _, o := range all {
+		if o.Start >= end {
+			res = append(res, o)
+			end = o.End
+		}
+	}
+	return res
+}
@@ -0,0 +1,90 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package index_test
+
+import (
+	"testing"
+
+	"github.com/chanced/caps/index"
+)
+
+func TestScanner(t *testing.T) {
+	idx := index.New(nil)
+	idx.Add("Http", "HTTP")
+	idx.Add("Https", "HTTPS")
+	idx.Add("Id", "ID")
+	idx.Add("Json", "JSON")
+	idx.Add("Sonar", "SONAR")
+	idx.Add("Über", "ÜBER")
+
+	type match struct {
+		text  string
+		value string
+	}
+	tests := []struct {
+		input   string
+		minLen  int
+		all     []match
+		longest []match
+	}{
+		{"getuserhttpclient", 0, []match{{"http", "HTTP"}}, []match{{"http", "HTTP"}}},
+		{"videoid", 0, nil, nil},
+		{"videoid", 2, []match{{"id", "ID"}, {"id", "ID"}}, []match{{"id", "ID"}, {"id", "ID"}}},
+		{"httpsjson", 0, []match{{"http", "HTTP"}, {"https", "HTTPS"}, {"json", "JSON"}}, []match{{"https", "HTTPS"}, {"json", "JSON"}}},
+		{"jsonar", 0, []match{{"json", "JSON"}, {"sonar", "SONAR"}}, []match{{"json", "JSON"}}},
+		{"HTTPJSON", 0, []match{{"HTTP", "HTTP"}, {"JSON", "JSON"}}, []match{{"HTTP", "HTTP"}, {"JSON", "JSON"}}},
+		{"großÜberhttp", 0, []match{{"Über", "ÜBER"}, {"http", "HTTP"}}, []match{{"Über", "ÜBER"}, {"http", "HTTP"}}},
+		{"\xffhttp", 0, []match{{"http", "HTTP"}}, []match{{"http", "HTTP"}}},
+		{"", 0, nil, nil},
+	}
+	check := func(t *testing.T, name, input string, expected []match, got []index.Occurrence) {
+		t.Helper()
+		if len(got) != len(expected) {
+			t.Fatalf("expected %s to return %d occurrences, got %d: %+v", name, len(expected), len(got), got)
+		}
+		for i, o := range got {
+			if input[o.Start:o.End] != expected[i].text {
+				t.Errorf("expected %s occurrence %d to be %q, got %q", name, i, expected[i].text, input[o.Start:o.End])
+			}
+			if o.Replacement.Screaming != expected[i].value {
+				t.Errorf("expected %s occurrence %d to have value %q, got %q", name, i, expected[i].value, o.Replacement.Screaming)
+			}
+		}
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			s := index.NewScanner(idx, test.minLen)
+			check(t, "FindAll", test.input, test.all, s.FindAll(test.input))
+			check(t, "FindLongest", test.input, test.longest, s.FindLongest(test.input))
+		})
+	}
+}
+
+func TestScannerEmpty(t *testing.T) {
+	s := index.NewScanner(index.New(nil), 0)
+	if got := s.FindAll("http"); got != nil {
+		t.Errorf("expected no occurrences, got %+v", got)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package inflect provides Rails style inflector helpers (e.g. Humanize,
+// Tableize, Classify) and English pluralization built on top of caps.
+//
+// Conversions are performed by a caps.Caps so that the helpers share the
+// replacements of the Caps they are created with. The package level functions
+// use caps.DefaultConverter and therefore agree with caps.ToCamel, caps.ToSnake
+// and the like on acronyms (e.g. Classify("api_keys") returns "APIKey").
+package inflect
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/chanced/caps"
+)
+
+// Inflector provides inflector helpers using a configured caps.Caps and
+// Rules.
+type Inflector struct {
+	caps  caps.Caps
+	rules *Rules
+}
+
+// New returns a new Inflector which performs conversions with c.
+//
+// If rules are not provided, DefaultRules are used.
+func New(c caps.Caps, rules ...*Rules) Inflector {
+	in := Inflector{caps: c, rules: DefaultRules}
+	for _, r := range rules {
+		if r != nil {
+			in.rules = r
+		}
+	}
+	return in
+}
+
+// Default is the Inflector used by the package level functions. It uses
+// caps.DefaultConverter.
+var Default = New(caps.New(caps.Config{Converter: caps.DefaultConverter}))
+
+// Caps returns the caps.Caps used by in.
+func (in Inflector) Caps() caps.Caps {
+	return in.caps
+}
+
+// Rules returns the Rules used by in.
+func (in Inflector) Rules() *Rules {
+	return in.rules
+}
+
+// Pluralize pluralizes the last word of str, as determined by the Tokenizer of
+// the Caps, while preserving the convention of str.
+//
+//	inflect.Pluralize("person") // people
+//	inflect.Pluralize("UserAccount") // UserAccounts
+//	inflect.Pluralize("user_category") // user_categories
+//	inflect.Pluralize("USER_CATEGORY") // USER_CATEGORIES
+func (in Inflector) Pluralize(str string) string {
+	return in.inflectLast(str, in.rules.PluralizeWord)
+}
+
+// Singularize singularizes the last word of str, as determined by the
+// Tokenizer of the Caps, while preserving the convention of str.
+//
+//	inflect.Singularize("statuses") // status
+//	inflect.Singularize("UserAccounts") // UserAccount
+func (in Inflector) Singularize(str string) string {
+	return in.inflectLast(str, in.rules.SingularizeWord)
+}
+
+// inflectLast replaces the last token of str with the result of fn. Tokens
+// without letters (e.g. "2") are not inflected.
+func (in Inflector) inflectLast(str string, fn func(string) string) string {
+	tokens := in.caps.Tokenizer().Tokenize(str, in.caps.AllowedSymbols(), in.caps.NumberRules())
+	if len(tokens) == 0 {
+		return str
+	}
+	last := tokens[len(tokens)-1]
+	if strings.IndexFunc(last, unicode.IsLetter) < 0 {
+		return str
+	}
+	i := strings.LastIndex(str, last)
+	if i < 0 {
+		return str
+	}
+	return str[:i] + fn(last) + str[i+len(last):]
+}
+
+// Humanize transforms str into a human readable phrase. A trailing "id" word is
+// removed, only the first word is capitalized, and replacements are written
+// in their screaming form.
+//
+//	inflect.Humanize("employee_salary") // Employee salary
+//	inflect.Humanize("author_id") // Author
+//	inflect.Humanize("httpRequestURL") // HTTP request URL
+func (in Inflector) Humanize(str string) string {
+	snake := in.caps.ToSnake(str)
+	if snake != "id" {
+		snake = strings.TrimSuffix(snake, "_id")
+	}
+	return in.caps.ToSentence(snake)
+}
+
+// Underscore transforms str into snake case. Namespace separators ("::") are
+// converted to "/".
+//
+//	inflect.Underscore("ActiveModel") // active_model
+//	inflect.Underscore("ActiveModel::Errors") // active_model/errors
+func (in Inflector) Underscore(str string) string {
+	parts := strings.Split(str, "::")
+	for i, p := range parts {
+		parts[i] = in.caps.ToSnake(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// Dasherize transforms str into kebab case.
+//
+// Unlike its Rails counterpart, which only replaces underscores with dashes,
+// Dasherize converts from any convention.
+//
+//	inflect.Dasherize("puni_puni") // puni-puni
+//	inflect.Dasherize("PuniPuni") // puni-puni
+func (in Inflector) Dasherize(str string) string {
+	return in.caps.ToKebab(str)
+}
+
+// Tableize transforms str, typically a class or model name, into the name of
+// a table: snake case with the last word pluralized.
+//
+//	inflect.Tableize("RawScaledScorer") // raw_scaled_scorers
+//	inflect.Tableize("ham_and_egg") // ham_and_eggs
+//	inflect.Tableize("Fancy::Category") // fancy/categories
+func (in Inflector) Tableize(str string) string {
+	return pluralizeLast(in.rules, in.Underscore(str))
+}
+
+// Classify transforms str, typically a table name, into the name of a class:
+// camel case with the last word singularized. Any schema prefix (e.g.
+// "schema.") is removed.
+//
+//	inflect.Classify("ham_and_eggs") // HamAndEgg
+//	inflect.Classify("schema.posts") // Post
+//	inflect.Classify("api_keys") // APIKey
+func (in Inflector) Classify(str string) string {
+	if i := strings.LastIndexByte(str, '.'); i >= 0 {
+		str = str[i+1:]
+	}
+	return in.caps.ToCamel(singularizeLast(in.rules, in.caps.ToSnake(str)))
+}
+
+// ForeignKey transforms str, typically a class name, into the name of a
+// foreign key column. The namespace is removed.
+//
+//	inflect.ForeignKey("Message") // message_id
+//	inflect.ForeignKey("Admin::Post") // post_id
+func (in Inflector) ForeignKey(str string) string {
+	key := in.caps.ToSnake(Demodulize(str))
+	if len(key) == 0 {
+		return key
+	}
+	return key + "_id"
+}
+
+// Demodulize removes the namespace (the text up to and including the last
+// "::") from str.
+//
+//	inflect.Demodulize("ActiveSupport::Inflector::Inflections") // Inflections
+func Demodulize[T ~string](str T) T {
+	if i := strings.LastIndex(string(str), "::"); i >= 0 {
+		return str[i+2:]
+	}
+	return str
+}
+
+// Pluralize pluralizes the last word of str using Default.
+//
+//	inflect.Pluralize("UserAccount") // UserAccounts
+func Pluralize[T ~string](str T) T {
+	return T(Default.Pluralize(string(str)))
+}
+
+// Singularize singularizes the last word of str using Default.
+//
+//	inflect.Singularize("user_categories") // user_category
+func Singularize[T ~string](str T) T {
+	return T(Default.Singularize(string(str)))
+}
+
+// Humanize transforms str into a human readable phrase using Default.
+//
+//	inflect.Humanize("author_id") // Author
+func Humanize[T ~string](str T) T {
+	return T(Default.Humanize(string(str)))
+}
+
+// Underscore transforms str into snake case using Default.
+//
+//	inflect.Underscore("ActiveModel::Errors") // active_model/errors
+func Underscore[T ~string](str T) T {
+	return T(Default.Underscore(string(str)))
+}
+
+// Dasherize transforms str into kebab case using Default.
+//
+//	inflect.Dasherize("puni_puni") // puni-puni
+func Dasherize[T ~string](str T) T {
+	return T(Default.Dasherize(string(str)))
+}
+
+// Tableize transforms str into the name of a table using Default.
+//
+//	inflect.Tableize("RawScaledScorer") // raw_scaled_scorers
+func Tableize[T ~string](str T) T {
+	return T(Default.Tableize(string(str)))
+}
+
+// Classify transforms str into the name of a class using Default.
+//
+//	inflect.Classify("ham_and_eggs") // HamAndEgg
+func Classify[T ~string](str T) T {
+	return T(Default.Classify(string(str)))
+}
+
+// ForeignKey transforms str into the name of a foreign key column using
+// Default.
+//
+//	inflect.ForeignKey("Admin::Post") // post_id
+func ForeignKey[T ~string](str T) T {
+	return T(Default.ForeignKey(string(str)))
+}
@@ -0,0 +1,124 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/chanced/caps"
+	"github.com/chanced/caps/inflect"
+)
+
+type testcase struct {
+	input    string
+	expected string
+}
+
+func run(t *testing.T, fn func(string) string, tests []testcase) {
+	t.Helper()
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if output := fn(test.input); output != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, output)
+			}
+		})
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	run(t, inflect.Humanize[string], []testcase{
+		{"", ""},
+		{"employee_salary", "Employee salary"},
+		{"author_id", "Author"},
+		{"AuthorID", "Author"},
+		{"httpRequestURL", "HTTP request URL"},
+		{"id", "ID"},
+	})
+}
+
+func TestUnderscore(t *testing.T) {
+	run(t, inflect.Underscore[string], []testcase{
+		{"ActiveModel", "active_model"},
+		{"ActiveModel::Errors", "active_model/errors"},
+		{"HTTPServer", "http_server"},
+	})
+}
+
+func TestDasherize(t *testing.T) {
+	run(t, inflect.Dasherize[string], []testcase{
+		{"puni_puni", "puni-puni"},
+		{"PuniPuni", "puni-puni"},
+	})
+}
+
+func TestTableize(t *testing.T) {
+	run(t, inflect.Tableize[string], []testcase{
+		{"RawScaledScorer", "raw_scaled_scorers"},
+		{"ham_and_egg", "ham_and_eggs"},
+		{"Fancy::Category", "fancy/categories"},
+		{"UserAddress", "user_addresses"},
+	})
+}
+
+func TestClassify(t *testing.T) {
+	run(t, inflect.Classify[string], []testcase{
+		{"ham_and_eggs", "HamAndEgg"},
+		{"schema.posts", "Post"},
+		{"user_categories", "UserCategory"},
+		{"api_keys", caps.ToCamel("api_key")},
+		{"addresses", "Address"},
+	})
+}
+
+func TestForeignKey(t *testing.T) {
+	run(t, inflect.ForeignKey[string], []testcase{
+		{"", ""},
+		{"Message", "message_id"},
+		{"Admin::Post", "post_id"},
+		{"HTTPRequest", "http_request_id"},
+	})
+}
+
+func TestDemodulize(t *testing.T) {
+	run(t, inflect.Demodulize[string], []testcase{
+		{"ActiveSupport::Inflector::Inflections", "Inflections"},
+		{"Inflections", "Inflections"},
+	})
+}
+
+func TestInflector(t *testing.T) {
+	in := inflect.New(caps.New(caps.Config{
+		Replacements: []caps.Replacement{{Camel: "Sku", Screaming: "SKU"}},
+	}))
+	if output := in.Classify("product_skus"); output != "ProductSKU" {
+		t.Errorf("expected %q, got %q", "ProductSKU", output)
+	}
+	if output := inflect.Classify("product_skus"); output != "ProductSku" {
+		t.Errorf("expected %q, got %q", "ProductSku", output)
+	}
+	if output := in.Humanize("sku_id"); output != "SKU" {
+		t.Errorf("expected %q, got %q", "SKU", output)
+	}
+}
@@ -0,0 +1,312 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package inflect
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rules is a set of rules for pluralizing and singularizing words.
+//
+// Rules are applied to the lowercase form of a word. Uncountable words are
+// returned as they are, irregular words are checked next and then the plural
+// or singular rules are evaluated, most recently added first, until one
+// matches. Irregular words also match the end of a compound word (e.g.
+// "salesperson" becomes "salespeople"), the longest taking precedence.
+//
+// Rules is safe for concurrent use.
+type Rules struct {
+	mu           sync.RWMutex
+	plurals      []rule
+	singulars    []rule
+	uncountables map[string]struct{}
+	// irregular plurals keyed by singular
+	irregularPlurals map[string]string
+	// irregular singulars keyed by plural
+	irregularSingulars map[string]string
+}
+
+type rule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// NewRules returns a new, empty, Rules.
+func NewRules() *Rules {
+	return &Rules{
+		uncountables:       make(map[string]struct{}),
+		irregularPlurals:   make(map[string]string),
+		irregularSingulars: make(map[string]string),
+	}
+}
+
+// EnglishRules returns a new Rules containing the English inflections.
+func EnglishRules() *Rules {
+	r := NewRules()
+	for _, p := range englishPlurals {
+		r.mustAddPlural(p[0], p[1])
+	}
+	for _, s := range englishSingulars {
+		r.mustAddSingular(s[0], s[1])
+	}
+	for _, i := range englishIrregulars {
+		r.AddIrregular(i[0], i[1])
+	}
+	r.AddUncountable(englishUncountables...)
+	return r
+}
+
+// DefaultRules are the Rules used by Default and by Inflectors created with
+// New unless others are provided. Rules added to DefaultRules affect those
+// Inflectors.
+var DefaultRules = EnglishRules()
+
+// AddPlural adds a rule which pluralizes words matching the regular expression
+// pattern by replacing the match with replacement (see regexp.Expand for the
+// syntax of replacement).
+//
+//	rules.AddPlural("(quiz)$", "${1}zes")
+func (r *Rules) AddPlural(pattern, replacement string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.plurals = append(r.plurals, rule{re: re, replacement: replacement})
+	return nil
+}
+
+// AddSingular adds a rule which singularizes words matching the regular
+// expression pattern by replacing the match with replacement (see
+// regexp.Expand for the syntax of replacement).
+//
+//	rules.AddSingular("(quiz)zes$", "${1}")
+func (r *Rules) AddSingular(pattern, replacement string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.singulars = append(r.singulars, rule{re: re, replacement: replacement})
+	return nil
+}
+
+func (r *Rules) mustAddPlural(pattern, replacement string) {
+	if err := r.AddPlural(pattern, replacement); err != nil {
+		panic(err)
+	}
+}
+
+func (r *Rules) mustAddSingular(pattern, replacement string) {
+	if err := r.AddSingular(pattern, replacement); err != nil {
+		panic(err)
+	}
+}
+
+// AddIrregular adds a word with an irregular plural form (e.g. "person" and
+// "people").
+func (r *Rules) AddIrregular(singular, plural string) {
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.uncountables, singular)
+	delete(r.uncountables, plural)
+	r.irregularPlurals[singular] = plural
+	r.irregularSingulars[plural] = singular
+}
+
+// AddUncountable adds words which have no plural form (e.g. "sheep").
+func (r *Rules) AddUncountable(words ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, w := range words {
+		r.uncountables[strings.ToLower(w)] = struct{}{}
+	}
+}
+
+// IsUncountable reports whether word has no plural form.
+func (r *Rules) IsUncountable(word string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.uncountables[strings.ToLower(word)]
+	return ok
+}
+
+// PluralizeWord returns the plural form of word, preserving its case (e.g.
+// "Person" becomes "People" and "CATEGORY" becomes "CATEGORIES").
+func (r *Rules) PluralizeWord(word string) string {
+	return matchCase(word, r.inflect(strings.ToLower(word), r.irregularPlurals, r.irregularSingulars, r.plurals))
+}
+
+// SingularizeWord returns the singular form of word, preserving its case.
+func (r *Rules) SingularizeWord(word string) string {
+	return matchCase(word, r.inflect(strings.ToLower(word), r.irregularSingulars, r.irregularPlurals, r.singulars))
+}
+
+func (r *Rules) inflect(word string, irregulars map[string]string, inverse map[string]string, rules []rule) string {
+	if len(word) == 0 {
+		return word
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.uncountables[word]; ok {
+		return word
+	}
+	for i := range word {
+		suffix := word[i:]
+		if res, ok := irregulars[suffix]; ok {
+			return word[:i] + res
+		}
+		if _, ok := inverse[suffix]; ok {
+			// already inflected
+			return word
+		}
+	}
+	for i := len(rules) - 1; i >= 0; i-- {
+		rl := rules[i]
+		if loc := rl.re.FindStringSubmatchIndex(word); loc != nil {
+			dst := rl.re.ExpandString(nil, rl.replacement, word, loc)
+			return word[:loc[0]] + string(dst) + word[loc[1]:]
+		}
+	}
+	return word
+}
+
+// matchCase returns res cased like word: uppercase if word is entirely
+// uppercase, capitalized if word begins with an uppercase letter, and
+// unchanged otherwise.
+func matchCase(word string, res string) string {
+	if len(word) == 0 || len(res) == 0 {
+		return res
+	}
+	if utf8.RuneCountInString(word) > 1 && strings.ToUpper(word) == word && strings.ToLower(word) != word {
+		return strings.ToUpper(res)
+	}
+	if first, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(first) {
+		r, w := utf8.DecodeRuneInString(res)
+		return string(unicode.ToTitle(r)) + res[w:]
+	}
+	return res
+}
+
+// pluralizeLast pluralizes the last word of snake, a snake case string which
+// may contain "/" namespace separators.
+func pluralizeLast(rules *Rules, snake string) string {
+	i := strings.LastIndexAny(snake, "_/") + 1
+	return snake[:i] + rules.PluralizeWord(snake[i:])
+}
+
+// singularizeLast singularizes the last word of snake, a snake case string
+// which may contain "/" namespace separators.
+func singularizeLast(rules *Rules, snake string) string {
+	i := strings.LastIndexAny(snake, "_/") + 1
+	return snake[:i] + rules.SingularizeWord(snake[i:])
+}
+
+// englishPlurals are in order of increasing precedence.
+var englishPlurals = [][2]string{
+	{"$", "s"},
+	{"s$", "s"},
+	{"^(ax|test)is$", "${1}es"},
+	{"(octop|vir)us$", "${1}i"},
+	{"(octop|vir)i$", "${1}i"},
+	{"(alias|status)$", "${1}es"},
+	{"(bu)s$", "${1}ses"},
+	{"(buffal|tomat)o$", "${1}oes"},
+	{"([ti])um$", "${1}a"},
+	{"([ti])a$", "${1}a"},
+	{"sis$", "ses"},
+	{"(?:([^f])fe|([lr])f)$", "${1}${2}ves"},
+	{"(hive)$", "${1}s"},
+	{"([^aeiouy]|qu)y$", "${1}ies"},
+	{"(x|ch|ss|sh)$", "${1}es"},
+	{"(matr|vert|ind)(?:ix|ex)$", "${1}ices"},
+	{"^(m|l)ouse$", "${1}ice"},
+	{"^(m|l)ice$", "${1}ice"},
+	{"^(ox)$", "${1}en"},
+	{"^(oxen)$", "${1}"},
+	{"(quiz)$", "${1}zes"},
+}
+
+// englishSingulars are in order of increasing precedence.
+var englishSingulars = [][2]string{
+	{"s$", ""},
+	{"(ss)$", "${1}"},
+	{"(n)ews$", "${1}ews"},
+	{"([ti])a$", "${1}um"},
+	{"((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$", "${1}sis"},
+	{"(^analy)(sis|ses)$", "${1}sis"},
+	{"([^f])ves$", "${1}fe"},
+	{"(hive)s$", "${1}"},
+	{"(tive)s$", "${1}"},
+	{"([lr])ves$", "${1}f"},
+	{"([^aeiouy]|qu)ies$", "${1}y"},
+	{"(s)eries$", "${1}eries"},
+	{"(m)ovies$", "${1}ovie"},
+	{"(x|ch|ss|sh)es$", "${1}"},
+	{"^(m|l)ice$", "${1}ouse"},
+	{"(bus)(es)?$", "${1}"},
+	{"(o)es$", "${1}"},
+	{"(shoe)s$", "${1}"},
+	{"(cris|test)(is|es)$", "${1}is"},
+	{"^(a)x[ie]s$", "${1}xis"},
+	{"(octop|vir)(us|i)$", "${1}us"},
+	{"(alias|status)(es)?$", "${1}"},
+	{"^(ox)en", "${1}"},
+	{"(vert|ind)ices$", "${1}ex"},
+	{"(matr)ices$", "${1}ix"},
+	{"(quiz)zes$", "${1}"},
+	{"(database)s$", "${1}"},
+}
+
+var englishIrregulars = [][2]string{
+	{"person", "people"},
+	{"man", "men"},
+	{"woman", "women"},
+	{"child", "children"},
+	{"sex", "sexes"},
+	{"move", "moves"},
+	{"zombie", "zombies"},
+	{"tooth", "teeth"},
+	{"foot", "feet"},
+	{"goose", "geese"},
+	// words ending in an irregular word which are inflected regularly
+	{"human", "humans"},
+	{"shaman", "shamans"},
+	{"talisman", "talismans"},
+	{"specimen", "specimens"},
+	{"mongoose", "mongooses"},
+}
+
+var englishUncountables = []string{
+	"equipment", "information", "rice", "money", "species", "series",
+	"fish", "sheep", "jeans", "police", "metadata", "news",
+}
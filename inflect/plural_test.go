@@ -0,0 +1,196 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package inflect_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/chanced/caps"
+	"github.com/chanced/caps/inflect"
+)
+
+var wordTests = []struct {
+	singular string
+	plural   string
+}{
+	{"post", "posts"},
+	{"category", "categories"},
+	{"status", "statuses"},
+	{"address", "addresses"},
+	{"person", "people"},
+	{"child", "children"},
+	{"man", "men"},
+	{"sheep", "sheep"},
+	{"information", "information"},
+	{"news", "news"},
+	{"series", "series"},
+	{"quiz", "quizzes"},
+	{"matrix", "matrices"},
+	{"vertex", "vertices"},
+	{"index", "indices"},
+	{"mouse", "mice"},
+	{"ox", "oxen"},
+	{"wife", "wives"},
+	{"half", "halves"},
+	{"analysis", "analyses"},
+	{"datum", "data"},
+	{"medium", "media"},
+	{"octopus", "octopi"},
+	{"axis", "axes"},
+	{"box", "boxes"},
+	{"church", "churches"},
+	{"day", "days"},
+	{"query", "queries"},
+	{"hive", "hives"},
+	{"bus", "buses"},
+	{"tomato", "tomatoes"},
+	{"shoe", "shoes"},
+	{"movie", "movies"},
+	{"database", "databases"},
+	{"alias", "aliases"},
+	{"salesperson", "salespeople"},
+	{"grandchild", "grandchildren"},
+	{"fireman", "firemen"},
+	{"chairwoman", "chairwomen"},
+	{"human", "humans"},
+}
+
+func TestPluralizeWord(t *testing.T) {
+	rules := inflect.EnglishRules()
+	for _, test := range wordTests {
+		t.Run(test.singular, func(t *testing.T) {
+			if output := rules.PluralizeWord(test.singular); output != test.plural {
+				t.Errorf("expected %q, got %q", test.plural, output)
+			}
+			if output := rules.PluralizeWord(test.plural); output != test.plural {
+				t.Errorf("expected pluralizing %q to return %q, got %q", test.plural, test.plural, output)
+			}
+		})
+	}
+}
+
+func TestSingularizeWord(t *testing.T) {
+	rules := inflect.EnglishRules()
+	for _, test := range wordTests {
+		t.Run(test.plural, func(t *testing.T) {
+			if output := rules.SingularizeWord(test.plural); output != test.singular {
+				t.Errorf("expected %q, got %q", test.singular, output)
+			}
+		})
+	}
+}
+
+func TestPluralize(t *testing.T) {
+	run(t, inflect.Pluralize[string], []testcase{
+		{"", ""},
+		{"person", "people"},
+		{"Person", "People"},
+		{"PERSON", "PEOPLE"},
+		{"UserAccount", "UserAccounts"},
+		{"userAccount", "userAccounts"},
+		{"user_category", "user_categories"},
+		{"USER_CATEGORY", "USER_CATEGORIES"},
+		{"user-status", "user-statuses"},
+		{"user.category", "user.categories"},
+		{"SalesPerson", "SalesPeople"},
+		{"Salesperson", "Salespeople"},
+		{"sales_person", "sales_people"},
+		{"HTTPRequest", "HTTPRequests"},
+		{"user_2", "user_2"},
+	})
+}
+
+func TestSingularize(t *testing.T) {
+	run(t, inflect.Singularize[string], []testcase{
+		{"statuses", "status"},
+		{"status", "status"},
+		{"People", "Person"},
+		{"Salespeople", "Salesperson"},
+		{"UserAccounts", "UserAccount"},
+		{"user_categories", "user_category"},
+		{"USER_CATEGORIES", "USER_CATEGORY"},
+	})
+}
+
+func TestRules(t *testing.T) {
+	rules := inflect.EnglishRules()
+	rules.AddIrregular("cactus", "cacti")
+	rules.AddUncountable("Software")
+	if err := rules.AddPlural("(ph)oto$", "${1}otoz"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rules.AddSingular("(ph)otoz$", "${1}oto"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rules.AddPlural("(", ""); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+	in := inflect.New(caps.New(), rules)
+	tests := []struct {
+		input    string
+		expected string
+		fn       func(string) string
+	}{
+		{"cactus", "cacti", in.Pluralize},
+		{"Cacti", "Cactus", in.Singularize},
+		{"software", "software", in.Pluralize},
+		{"user_photo", "user_photoz", in.Pluralize},
+		{"UserPhotoz", "UserPhoto", in.Singularize},
+		{"user_photo", "user_photos", inflect.Pluralize[string]},
+		{"cactus", "cactus", inflect.Pluralize[string]},
+	}
+	for _, test := range tests {
+		if output := test.fn(test.input); output != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, output)
+		}
+	}
+	if !rules.IsUncountable("SOFTWARE") {
+		t.Error("expected software to be uncountable")
+	}
+	if output := in.Tableize("Cactus"); output != "cacti" {
+		t.Errorf("expected %q, got %q", "cacti", output)
+	}
+	if output := in.Classify("cacti"); output != "Cactus" {
+		t.Errorf("expected %q, got %q", "Cactus", output)
+	}
+}
+
+func TestRulesConcurrency(t *testing.T) {
+	rules := inflect.EnglishRules()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			rules.AddUncountable("equipment")
+		}()
+		go func() {
+			defer wg.Done()
+			rules.PluralizeWord("category")
+		}()
+	}
+	wg.Wait()
+}
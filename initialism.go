@@ -0,0 +1,183 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultStopWords are the words skipped by ToInitialism if
+// InitialismOpts.SkipStopWords is set and StopWords are not provided.
+var DefaultStopWords = concatWords(titleArticles, titleConjunctions, titleShortPrepositions)
+
+// InitialismOpts include configurable options for ToInitialism.
+//
+// See the documentation for the individual fields for more information.
+type InitialismOpts struct {
+	Opts
+	// SkipStopWords indicates that stop words (e.g. "of", "the") do not
+	// contribute to the initialism, unless the input consists solely of stop
+	// words.
+	//
+	// Default:
+	//  false
+	SkipStopWords bool
+	// StopWords are the words skipped if SkipStopWords is set. They are
+	// compared case-insensitively.
+	//
+	// Default:
+	//  DefaultStopWords
+	StopWords []string
+	// KeepDigits indicates that the digits of each word are kept (e.g. "Web 3
+	// Foundation" becomes "W3F"). Otherwise, digits are dropped and words
+	// which begin with a digit are skipped.
+	//
+	// Default:
+	//  false
+	KeepDigits bool
+	// Existing are initialisms which are already in use. If the initialism is
+	// in Existing (compared case-insensitively), letters of the last word are
+	// appended until it is unique (e.g. "UAS" becomes "UAST"). If the letters
+	// run out, a number is appended instead (e.g. "UAS2").
+	//
+	// Default:
+	//  nil
+	Existing []string
+}
+
+func loadInitialismOpts(opts []InitialismOpts) InitialismOpts {
+	options := make([]Opts, len(opts))
+	result := InitialismOpts{}
+	for i, opt := range opts {
+		options[i] = opt.Opts
+		if opt.SkipStopWords {
+			result.SkipStopWords = true
+		}
+		if opt.StopWords != nil {
+			result.StopWords = append(result.StopWords, opt.StopWords...)
+		}
+		if opt.KeepDigits {
+			result.KeepDigits = true
+		}
+		if opt.Existing != nil {
+			result.Existing = append(result.Existing, opt.Existing...)
+		}
+	}
+	if result.StopWords == nil {
+		result.StopWords = DefaultStopWords
+	}
+	result.Opts = loadOpts(options)
+	return result
+}
+
+// ToInitialism returns the initialism of str (e.g. "Application Programming
+// Interface" becomes "API") made up of the upper case first letter of each
+// word using either the provided Converter or the DefaultConverter otherwise.
+//
+// Words are determined by the Converter, so str may be in any convention and
+// replacements are treated as a single word (e.g. "HTTPServer" becomes "HS").
+//
+//	caps.ToInitialism("Application Programming Interface") // API
+//	caps.ToInitialism("user_account_status") // UAS
+//	caps.ToInitialism("Bank of America", caps.InitialismOpts{SkipStopWords: true}) // BA
+func ToInitialism[T ~string](str T, options ...InitialismOpts) T {
+	opts := loadInitialismOpts(options)
+	words := strings.Fields(opts.Converter.Convert(ConvertRequest{
+		Style:          StyleCamel,
+		ReplaceStyle:   ReplaceStyleScreaming,
+		Input:          string(str),
+		Join:           " ",
+		AllowedSymbols: opts.AllowedSymbols,
+		NumberRules:    opts.NumberRules,
+	}))
+	if opts.SkipStopWords {
+		stop := make(map[string]struct{}, len(opts.StopWords))
+		for _, w := range opts.StopWords {
+			stop[strings.ToLower(w)] = struct{}{}
+		}
+		var kept []string
+		for _, w := range words {
+			if _, ok := stop[strings.ToLower(w)]; !ok {
+				kept = append(kept, w)
+			}
+		}
+		if len(kept) > 0 {
+			words = kept
+		}
+	}
+
+	var b strings.Builder
+	// last is the remainder of the last word which contributed to the
+	// initialism
+	var last string
+	for _, w := range words {
+		r, n := utf8.DecodeRuneInString(w)
+		if unicode.IsDigit(r) && !opts.KeepDigits {
+			continue
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		last = w[n:]
+		if opts.KeepDigits {
+			for _, r := range w[n:] {
+				if unicode.IsDigit(r) {
+					b.WriteRune(r)
+				}
+			}
+		}
+	}
+	return T(dedupInitialism(b.String(), last, opts.Existing))
+}
+
+// dedupInitialism appends the letters of last to initialism, then a number,
+// until it is not in existing.
+func dedupInitialism(initialism string, last string, existing []string) string {
+	if len(existing) == 0 || len(initialism) == 0 {
+		return initialism
+	}
+	taken := make(map[string]struct{}, len(existing))
+	for _, e := range existing {
+		taken[strings.ToUpper(e)] = struct{}{}
+	}
+	isTaken := func(s string) bool {
+		_, ok := taken[strings.ToUpper(s)]
+		return ok
+	}
+	res := initialism
+	for _, r := range last {
+		if !isTaken(res) {
+			return res
+		}
+		if unicode.IsLetter(r) {
+			res += string(unicode.ToUpper(r))
+		}
+	}
+	for n := 2; isTaken(res); n++ {
+		res = initialism + strconv.Itoa(n)
+	}
+	return res
+}
@@ -0,0 +1,76 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"testing"
+
+	"github.com/chanced/caps"
+)
+
+func TestToInitialism(t *testing.T) {
+	tests := []struct {
+		input    string
+		opts     caps.InitialismOpts
+		expected string
+	}{
+		{"", caps.InitialismOpts{}, ""},
+		{"Application Programming Interface", caps.InitialismOpts{}, "API"},
+		{"user_account_status", caps.InitialismOpts{}, "UAS"},
+		{"userAccountStatus", caps.InitialismOpts{}, "UAS"},
+		{"HTTPServerConfig", caps.InitialismOpts{}, "HSC"},
+		{"Bank of America", caps.InitialismOpts{}, "BOA"},
+		{"Bank of America", caps.InitialismOpts{SkipStopWords: true}, "BA"},
+		{"The Lord of the Rings", caps.InitialismOpts{SkipStopWords: true}, "LR"},
+		{"the of", caps.InitialismOpts{SkipStopWords: true}, "TO"},
+		{"Bank of America", caps.InitialismOpts{SkipStopWords: true, StopWords: []string{"Bank"}}, "OA"},
+		{"Web 3 Foundation", caps.InitialismOpts{}, "WF"},
+		{"Web 3 Foundation", caps.InitialismOpts{KeepDigits: true}, "W3F"},
+		{"Area 51 report", caps.InitialismOpts{KeepDigits: true}, "A51R"},
+		{"user_account_status", caps.InitialismOpts{Existing: []string{"uas"}}, "UAST"},
+		{"user_account_status", caps.InitialismOpts{Existing: []string{"UAS", "UAST"}}, "UASTA"},
+		{"user_id", caps.InitialismOpts{Existing: []string{"UI"}}, "UID"},
+		{"user_id", caps.InitialismOpts{Existing: []string{"UI", "UID"}}, "UI2"},
+		{"user_id", caps.InitialismOpts{Existing: []string{"UI", "UID", "UI2"}}, "UI3"},
+		{"über straße", caps.InitialismOpts{}, "ÜS"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := caps.ToInitialism(test.input, test.opts); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCapsToInitialism(t *testing.T) {
+	c := caps.New(caps.Config{AllowedSymbols: "#"})
+	if got := c.ToInitialism("c# developer network"); got != "CDN" {
+		t.Errorf("expected %q, got %q", "CDN", got)
+	}
+	if got := c.ToInitialism("the c# developer network", caps.InitialismOpts{SkipStopWords: true}); got != "CDN" {
+		t.Errorf("expected %q, got %q", "CDN", got)
+	}
+}
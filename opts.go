@@ -142,6 +142,24 @@ type Opts struct {
 	// Note, if you add special characters here, they must be present in the
 	// AllowedSymbols string for them to be part of the output.
 	NumberRules token.NumberRules
+	// TitleStyle is the style guide ToTitle follows for lowercasing small
+	// words (e.g. "of", "the").
+	//
+	// Default:
+	//  TitleStyleNotSpecified (every word is capitalized)
+	TitleStyle TitleStyle
+	// ProperNouns are written by ToSentence as they appear in this list
+	// (e.g. "GitHub", "New York") rather than in lowercase.
+	//
+	// Default:
+	//  nil
+	ProperNouns []string
+	// MaxLen is the maximum length, in bytes, of the output of the TryTo
+	// functions (e.g. TryToCamel). It is not enforced by the To functions.
+	//
+	// Default:
+	//  0 (unlimited)
+	MaxLen int
 }
 
 // WithConverter sets the Converter to use
@@ -191,6 +209,28 @@ func WithNumberRules(rules NumberRules) Opts {
 	}
 }
 
+// WithTitleStyle sets the TitleStyle to use
+func WithTitleStyle(style TitleStyle) Opts {
+	return Opts{
+		TitleStyle: style,
+	}
+}
+
+// WithProperNouns adds proper nouns which ToSentence writes as provided
+func WithProperNouns(nouns ...string) Opts {
+	return Opts{
+		ProperNouns: nouns,
+	}
+}
+
+// WithMaxLen sets the maximum length, in bytes, of the output of the TryTo
+// functions
+func WithMaxLen(maxLen int) Opts {
+	return Opts{
+		MaxLen: maxLen,
+	}
+}
+
 // WithAllowedSymbols sets the AllowedSymbols to use
 func WithAllowedSymbols(symbols string) Opts {
 	return Opts{
@@ -212,6 +252,15 @@ func loadOpts(opts []Opts) Opts {
 		if opt.ReplaceStyle != ReplaceStyleNotSpecified {
 			result.ReplaceStyle = opt.ReplaceStyle
 		}
+		if opt.TitleStyle != TitleStyleNotSpecified {
+			result.TitleStyle = opt.TitleStyle
+		}
+		if opt.ProperNouns != nil {
+			result.ProperNouns = append(result.ProperNouns, opt.ProperNouns...)
+		}
+		if opt.MaxLen > 0 {
+			result.MaxLen = opt.MaxLen
+		}
 		if len(opt.NumberRules) > 0 {
 			if result.NumberRules == nil {
 				result.NumberRules = make(NumberRules)
@@ -224,6 +273,52 @@ func loadOpts(opts []Opts) Opts {
 	return result
 }
 
+// SlugOpts include configurable options for ToSlug.
+//
+// See the documentation for the individual fields for more information.
+type SlugOpts struct {
+	Opts
+	// Style is the case style of the slug.
+	//
+	// Default:
+	//  StyleLower
+	Style Style
+	// Delimiter is used to join the words of the slug.
+	//
+	// Default:
+	//  "-"
+	Delimiter string
+	// MaxLen is the maximum length, in bytes, of the slug. Slugs which exceed
+	// MaxLen are cut at the last word boundary which fits. A single word
+	// longer than MaxLen is truncated.
+	//
+	// Default:
+	//  0 (no limit)
+	MaxLen int
+}
+
+func loadSlugOpts(opts []SlugOpts) SlugOpts {
+	options := make([]Opts, len(opts))
+	result := SlugOpts{
+		Style:     StyleLower,
+		Delimiter: "-",
+	}
+	for i, opt := range opts {
+		options[i] = opt.Opts
+		if opt.Style != StyleNotSpecified {
+			result.Style = opt.Style
+		}
+		if opt.Delimiter != "" {
+			result.Delimiter = opt.Delimiter
+		}
+		if opt.MaxLen > 0 {
+			result.MaxLen = opt.MaxLen
+		}
+	}
+	result.Opts = loadOpts(options)
+	return result
+}
+
 // Config include configurable options for Caps instances.
 //
 // See the documentation for the individual fields for more information.
@@ -270,6 +365,26 @@ type Config struct {
 
 	// If not set, uses StdTokenizer with the provided delimiters and token.Caser.
 	Tokenizer Tokenizer
+
+	// Graphemes enables extended grapheme cluster (user-perceived character)
+	// segmentation. If Tokenizer is not set, the StdTokenizer is created with
+	// TokenizerOpts.Graphemes. UpperFirst and LowerFirst operate on the first
+	// grapheme cluster rather than the first rune.
+	//
+	// Default: false
+	Graphemes bool
+
+	// TitleStyle is the style guide ToTitle follows for lowercasing small
+	// words (e.g. "of", "the").
+	//
+	// Default: TitleStyleNotSpecified (every word is capitalized)
+	TitleStyle TitleStyle
+
+	// ProperNouns are written by ToSentence as they appear in this list
+	// (e.g. "GitHub", "New York") rather than in lowercase.
+	//
+	// Default: nil
+	ProperNouns []string
 }
 
 func loadConfig(opts []Config) Config {
@@ -286,6 +401,12 @@ func loadConfig(opts []Config) Config {
 		if opt.ReplaceStyle != ReplaceStyleNotSpecified {
 			result.ReplaceStyle = opt.ReplaceStyle
 		}
+		if opt.TitleStyle != TitleStyleNotSpecified {
+			result.TitleStyle = opt.TitleStyle
+		}
+		if opt.ProperNouns != nil {
+			result.ProperNouns = append(result.ProperNouns, opt.ProperNouns...)
+		}
 		if len(opt.NumberRules) > 0 {
 			if result.NumberRules == nil {
 				result.NumberRules = make(NumberRules)
@@ -303,6 +424,9 @@ func loadConfig(opts []Config) Config {
 		if opt.Tokenizer != nil {
 			result.Tokenizer = opt.Tokenizer
 		}
+		if opt.Graphemes {
+			result.Graphemes = true
+		}
 	}
 	if result.Caser == nil {
 		result.Caser = token.DefaultCaser
@@ -311,7 +435,7 @@ func loadConfig(opts []Config) Config {
 		result.Replacements = DefaultReplacements
 	}
 	if result.Tokenizer == nil {
-		result.Tokenizer = NewTokenizer(DEFAULT_DELIMITERS, result.Caser)
+		result.Tokenizer = NewTokenizer(DEFAULT_DELIMITERS, result.Caser, TokenizerOpts{Graphemes: result.Graphemes})
 	}
 	if result.Converter == nil {
 		result.Converter = NewConverter(result.Replacements, result.Tokenizer, result.Caser)
@@ -0,0 +1,242 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import "github.com/chanced/caps/token"
+
+// Plan is a precompiled set of options for case conversion, created with
+// Compile or Caps.Compile.
+//
+// The options of a Plan are loaded once rather than on each call: allowed
+// symbols are sorted, NumberRules are copied, and if the Converter is a
+// StdConverter, its replacements are copied so that later calls to Set or
+// Delete on the StdConverter do not affect the Plan. Copying is proportional
+// to the number of replacements unless the StdConverter was created with
+// NewConverterFromFrozen, whose replacements are shared as they are
+// immutable. If the StdConverter uses a StdTokenizer, the Plan tokenizes with
+// the prepared symbols directly.
+//
+// A Plan is safe for concurrent use.
+type Plan struct {
+	converter      Converter
+	replaceStyle   ReplaceStyle
+	allowedSymbols string
+	numberRules    NumberRules
+	titleStyle     TitleStyle
+	caser          token.Caser
+
+	// std is set if converter is a StdConverter with a StdTokenizer
+	std       *StdConverter
+	tokenizer StdTokenizer
+	symbols   symbolSet
+	// titleSymbols are the symbols of ToTitle (see titleSymbols)
+	titleSymbols symbolSet
+}
+
+// Compile loads options and returns a Plan with conversion methods
+// equivalent to the package level functions (e.g. ToCamel) called with
+// options.
+//
+//	plan := caps.Compile(caps.WithAllowedSymbols("$"))
+//	for i, key := range keys {
+//		keys[i] = plan.ToSnake(key)
+//	}
+func Compile(options ...Opts) *Plan {
+	opts := loadOpts(options)
+	return newPlan(opts.Converter, opts.ReplaceStyle, opts.AllowedSymbols, opts.NumberRules, opts.TitleStyle, token.DefaultCaser)
+}
+
+func newPlan(converter Converter, replaceStyle ReplaceStyle, allowedSymbols string, numberRules NumberRules, titleStyle TitleStyle, caser token.Caser) *Plan {
+	p := &Plan{
+		converter:      converter,
+		replaceStyle:   replaceStyle,
+		allowedSymbols: allowedSymbols,
+		titleStyle:     titleStyle,
+		caser:          token.CaserOrDefault(caser),
+	}
+	if len(numberRules) > 0 {
+		p.numberRules = make(NumberRules, len(numberRules))
+		for k, v := range numberRules {
+			p.numberRules[k] = v
+		}
+	}
+	var sc *StdConverter
+	switch c := converter.(type) {
+	case StdConverter:
+		sc = &c
+	case *StdConverter:
+		sc = c
+	}
+	if sc == nil {
+		return p
+	}
+	std := sc.clone()
+	p.converter = std
+	switch t := std.tokenizer.(type) {
+	case StdTokenizer:
+		p.tokenizer = t
+	case *StdTokenizer:
+		p.tokenizer = *t
+	default:
+		return p
+	}
+	p.std = &std
+	p.symbols = newSymbolSet(allowedSymbols)
+	p.titleSymbols = newSymbolSet(titleSymbols(titleStyle, allowedSymbols))
+	return p
+}
+
+// Converter returns the Converter used by p.
+//
+// If p was compiled with a StdConverter, this is a copy of it.
+func (p *Plan) Converter() Converter {
+	return p.converter
+}
+
+func (p *Plan) convert(req ConvertRequest) string {
+	return p.convertSymbols(req, p.symbols)
+}
+
+// convertSymbols is like convert but tokenizes with symbols, which must be
+// the symbolSet of req.AllowedSymbols.
+func (p *Plan) convertSymbols(req ConvertRequest, symbols symbolSet) string {
+	if p.std == nil {
+		return p.converter.Convert(req)
+	}
+	req.Input = p.std.spell(req.Input)
+	return p.std.convert(req, p.tokenizer.tokenizeSymbols(req.Input, symbols, req.NumberRules))
+}
+
+// ToCamel transforms the case of str into Camel Case (e.g. AnExampleString).
+//
+// See the package level ToCamel for more information.
+func (p *Plan) ToCamel(str string) string {
+	return p.convert(ConvertRequest{
+		Style:          StyleCamel,
+		ReplaceStyle:   p.replaceStyle,
+		Input:          str,
+		Join:           "",
+		AllowedSymbols: p.allowedSymbols,
+		NumberRules:    p.numberRules,
+	})
+}
+
+// ToLowerCamel transforms the case of str into Lower Camel Case (e.g.
+// anExampleString).
+//
+// See the package level ToLowerCamel for more information.
+func (p *Plan) ToLowerCamel(str string) string {
+	return p.convert(ConvertRequest{
+		Style:          StyleLowerCamel,
+		ReplaceStyle:   p.replaceStyle,
+		Input:          str,
+		Join:           "",
+		AllowedSymbols: p.allowedSymbols,
+		NumberRules:    p.numberRules,
+	})
+}
+
+// ToSnake transforms the case of str into Lower Snake Case (e.g.
+// an_example_string).
+func (p *Plan) ToSnake(str string) string {
+	return p.ToDelimited(str, "_", true)
+}
+
+// ToScreamingSnake transforms the case of str into Screaming Snake Case (e.g.
+// AN_EXAMPLE_STRING).
+func (p *Plan) ToScreamingSnake(str string) string {
+	return p.ToDelimited(str, "_", false)
+}
+
+// ToKebab transforms the case of str into Lower Kebab Case (e.g.
+// an-example-string).
+func (p *Plan) ToKebab(str string) string {
+	return p.ToDelimited(str, "-", true)
+}
+
+// ToScreamingKebab transforms the case of str into Screaming Kebab Case (e.g.
+// AN-EXAMPLE-STRING).
+func (p *Plan) ToScreamingKebab(str string) string {
+	return p.ToDelimited(str, "-", false)
+}
+
+// ToDotNotation transforms the case of str into Lower Dot Notation Case (e.g.
+// an.example.string).
+func (p *Plan) ToDotNotation(str string) string {
+	return p.ToDelimited(str, ".", true)
+}
+
+// ToScreamingDotNotation transforms the case of str into Screaming Dot
+// Notation Case (e.g. AN.EXAMPLE.STRING).
+func (p *Plan) ToScreamingDotNotation(str string) string {
+	return p.ToDelimited(str, ".", false)
+}
+
+// ToTitle transforms the case of str into Title Case (e.g. An Example String).
+//
+// See the package level ToTitle for more information.
+func (p *Plan) ToTitle(str string) string {
+	return applyTitleStyle(p.caser, p.titleStyle, p.convertSymbols(ConvertRequest{
+		Style:          StyleCamel,
+		ReplaceStyle:   p.replaceStyle,
+		Input:          str,
+		Join:           " ",
+		AllowedSymbols: titleSymbols(p.titleStyle, p.allowedSymbols),
+		NumberRules:    p.numberRules,
+	}, p.titleSymbols))
+}
+
+// ToDelimited transforms the case of str into a string separated by delimiter,
+// using either lower case if lowercase is true or upper case otherwise.
+//
+// See the package level ToDelimited for more information.
+func (p *Plan) ToDelimited(str string, delimiter string, lowercase bool) string {
+	var style Style
+	var replacementStyle ReplaceStyle
+	if lowercase {
+		style = StyleLower
+		replacementStyle = ReplaceStyleLower
+	} else {
+		style = StyleScreaming
+		replacementStyle = ReplaceStyleScreaming
+	}
+	return p.convert(ConvertRequest{
+		Style:          style,
+		ReplaceStyle:   replacementStyle,
+		Input:          str,
+		Join:           delimiter,
+		AllowedSymbols: p.allowedSymbols,
+		NumberRules:    p.numberRules,
+	})
+}
+
+// ToConvention transforms str into the naming convention cv. If cv is
+// ConventionUnknown, str is returned unchanged.
+func (p *Plan) ToConvention(str string, cv Convention) string {
+	if cv == ConventionUnknown {
+		return str
+	}
+	return p.convert(cv.request(str, p.replaceStyle, p.allowedSymbols, p.numberRules))
+}
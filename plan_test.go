@@ -0,0 +1,116 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"testing"
+
+	"github.com/chanced/caps"
+	"github.com/chanced/caps/token"
+)
+
+func TestPlan(t *testing.T) {
+	inputs := []string{
+		"",
+		"user_account_id",
+		"getUserHTTPResponseCode",
+		"This is [an] {example}${id32}.",
+		"AN_EXAMPLE_STRING with id 12.5",
+		"version 1.2.3 of the json api",
+		"Crème Brûlée über_straße",
+		"$price_is_12.50",
+	}
+	optionSets := map[string][]caps.Opts{
+		"default": nil,
+		"symbols": {caps.WithAllowedSymbols("$.")},
+		"rules": {
+			caps.WithAllowedSymbols("$"),
+			caps.WithNumberRules(token.NumberRules{'$': func(i int, r rune, val string) bool { return i == 0 }}),
+		},
+		"replace style": {caps.WithReplaceStyleCamel(), caps.WithTitleStyle(caps.TitleStyleChicago)},
+		"converter":     {caps.WithConverter(caps.NewConverter(nil, caps.DefaultTokenizer, token.DefaultCaser))},
+	}
+	for name, options := range optionSets {
+		t.Run(name, func(t *testing.T) {
+			plan := caps.Compile(options...)
+			for _, input := range inputs {
+				tests := []struct {
+					name     string
+					expected string
+					got      string
+				}{
+					{"ToCamel", caps.ToCamel(input, options...), plan.ToCamel(input)},
+					{"ToLowerCamel", caps.ToLowerCamel(input, options...), plan.ToLowerCamel(input)},
+					{"ToSnake", caps.ToSnake(input, options...), plan.ToSnake(input)},
+					{"ToScreamingSnake", caps.ToScreamingSnake(input, options...), plan.ToScreamingSnake(input)},
+					{"ToKebab", caps.ToKebab(input, options...), plan.ToKebab(input)},
+					{"ToScreamingKebab", caps.ToScreamingKebab(input, options...), plan.ToScreamingKebab(input)},
+					{"ToDotNotation", caps.ToDotNotation(input, options...), plan.ToDotNotation(input)},
+					{"ToScreamingDotNotation", caps.ToScreamingDotNotation(input, options...), plan.ToScreamingDotNotation(input)},
+					{"ToTitle", caps.ToTitle(input, options...), plan.ToTitle(input)},
+					{"ToConvention", caps.ToConvention(input, caps.ConventionKebab, options...), plan.ToConvention(input, caps.ConventionKebab)},
+				}
+				for _, test := range tests {
+					if test.got != test.expected {
+						t.Errorf("%s(%q): expected %q, got %q", test.name, input, test.expected, test.got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPlanIsolated(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, token.DefaultCaser)
+	rules := token.NumberRules{'$': func(i int, r rune, val string) bool { return i == 0 }}
+	plan := caps.Compile(caps.WithConverter(&converter), caps.WithNumberRules(rules), caps.WithAllowedSymbols("$"))
+
+	converter.Set("Foo", "FOO")
+	converter.Delete("Json")
+	delete(rules, '$')
+
+	if got := plan.ToCamel("json_foo"); got != "JSONFoo" {
+		t.Errorf("expected %q, got %q", "JSONFoo", got)
+	}
+	if got := plan.ToSnake("$12"); got != "$12" {
+		t.Errorf("expected %q, got %q", "$12", got)
+	}
+}
+
+func TestCapsCompile(t *testing.T) {
+	c := caps.New(caps.Config{
+		Replacements: []caps.Replacement{{Camel: "Uuid", Screaming: "UUID"}},
+		TitleStyle:   caps.TitleStyleAP,
+	})
+	plan := c.Compile()
+	for _, input := range []string{"user_uuid", "the lord of the rings", "userJSON"} {
+		if expected, got := c.ToCamel(input), plan.ToCamel(input); got != expected {
+			t.Errorf("ToCamel(%q): expected %q, got %q", input, expected, got)
+		}
+		if expected, got := c.ToTitle(input), plan.ToTitle(input); got != expected {
+			t.Errorf("ToTitle(%q): expected %q, got %q", input, expected, got)
+		}
+	}
+}
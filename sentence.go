@@ -0,0 +1,93 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"strings"
+
+	"github.com/chanced/caps/token"
+)
+
+// toSentence converts str into sentence case with converter, writing
+// replacements in replaceStyle and restoring properNouns.
+func toSentence(converter Converter, caser token.Caser, str string, replaceStyle ReplaceStyle, allowed string, numberRules NumberRules, properNouns []string) string {
+	req := ConvertRequest{
+		Style:          StyleLower,
+		ReplaceStyle:   replaceStyle,
+		Input:          str,
+		Join:           " ",
+		AllowedSymbols: allowed,
+		NumberRules:    numberRules,
+	}
+	res := converter.Convert(req)
+	if len(res) == 0 {
+		return res
+	}
+	if len(properNouns) > 0 {
+		words := strings.Split(res, " ")
+		for _, noun := range properNouns {
+			req.Input = noun
+			words = replaceWords(words, strings.Split(converter.Convert(req), " "), noun)
+			if !strings.ContainsRune(noun, ' ') {
+				// a single word proper noun, such as "GitHub", may be
+				// tokenized into multiple words
+				words = replaceWords(words, []string{noun}, noun)
+			}
+		}
+		res = strings.Join(words, " ")
+	}
+	return token.UpperFirst(caser, res)
+}
+
+// replaceWords replaces each occurrence of the sequence old in words, compared
+// with simple case folding, with the single word new.
+func replaceWords(words []string, old []string, new string) []string {
+	if len(old) == 0 || len(old[0]) == 0 {
+		return words
+	}
+	res := words[:0:0]
+	for i := 0; i < len(words); {
+		if hasWordsAt(words, old, i) {
+			res = append(res, new)
+			i += len(old)
+			continue
+		}
+		res = append(res, words[i])
+		i++
+	}
+	return res
+}
+
+func hasWordsAt(words []string, seq []string, i int) bool {
+	if len(words)-i < len(seq) {
+		return false
+	}
+	for j, w := range seq {
+		if !strings.EqualFold(words[i+j], w) {
+			return false
+		}
+	}
+	return true
+}
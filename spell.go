@@ -0,0 +1,258 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SpellMode determines which numbers SpellNumbers spells out.
+type SpellMode uint8
+
+const (
+	SpellModeNotSpecified SpellMode = iota
+	SpellModeLeading                // Only a number at the start of the text is spelled out (e.g. "3d_model_2" becomes "three d_model_2").
+	SpellModeAll                    // All numbers are spelled out (e.g. "3d_model_2" becomes "three d_model_two").
+)
+
+func (m SpellMode) String() string {
+	switch m {
+	case SpellModeLeading:
+		return "SpellModeLeading"
+	case SpellModeAll:
+		return "SpellModeAll"
+	}
+	return "SpellModeNotSpecified"
+}
+
+// Speller spells out numbers as words.
+type Speller interface {
+	// Cardinal returns the words of n (e.g. "twenty one"), separated by
+	// spaces.
+	Cardinal(n uint64) string
+	// Ordinal returns the words of the ordinal n (e.g. "twenty first"),
+	// separated by spaces.
+	Ordinal(n uint64) string
+	// OrdinalSuffix returns the length, in bytes, of the ordinal suffix (e.g.
+	// "st" of "1st") at the start of s, which follows the digits of n, or 0
+	// if s does not begin with the ordinal suffix of n.
+	OrdinalSuffix(n uint64, s string) int
+}
+
+// DefaultSpeller is the Speller used by SpellNumbers if one is not provided.
+var DefaultSpeller Speller = EnglishSpeller{}
+
+// EnglishSpeller is a Speller for English numbers, using the short scale
+// (e.g. "one billion" is 1,000,000,000).
+type EnglishSpeller struct{}
+
+var (
+	englishOnes = [...]string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens   = [...]string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = [...]string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+
+	englishOrdinals = map[string]string{
+		"one":    "first",
+		"two":    "second",
+		"three":  "third",
+		"five":   "fifth",
+		"eight":  "eighth",
+		"nine":   "ninth",
+		"twelve": "twelfth",
+	}
+)
+
+// Cardinal returns the English words of n (e.g. "one hundred twenty three").
+func (EnglishSpeller) Cardinal(n uint64) string {
+	if n == 0 {
+		return englishOnes[0]
+	}
+	// groups holds n in groups of three digits, least significant first
+	var groups []uint64
+	for ; n > 0; n /= 1000 {
+		groups = append(groups, n%1000)
+	}
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			continue
+		}
+		if g >= 100 {
+			words = append(words, englishOnes[g/100], "hundred")
+			g %= 100
+		}
+		switch {
+		case g >= 20:
+			words = append(words, englishTens[g/10])
+			if g%10 > 0 {
+				words = append(words, englishOnes[g%10])
+			}
+		case g > 0:
+			words = append(words, englishOnes[g])
+		}
+		if i > 0 {
+			words = append(words, englishScales[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Ordinal returns the English words of the ordinal n (e.g. "one hundred
+// twenty third").
+func (s EnglishSpeller) Ordinal(n uint64) string {
+	words := s.Cardinal(n)
+	i := strings.LastIndexByte(words, ' ') + 1
+	last := words[i:]
+	switch {
+	case englishOrdinals[last] != "":
+		last = englishOrdinals[last]
+	case strings.HasSuffix(last, "y"):
+		last = last[:len(last)-1] + "ieth"
+	default:
+		last += "th"
+	}
+	return words[:i] + last
+}
+
+// OrdinalSuffix returns 2 if s begins with the English ordinal suffix of n
+// (i.e. "st", "nd", "rd" or "th"), compared case-insensitively.
+func (EnglishSpeller) OrdinalSuffix(n uint64, s string) int {
+	if len(s) < 2 {
+		return 0
+	}
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	if strings.EqualFold(s[:2], suffix) {
+		return 2
+	}
+	return 0
+}
+
+// SpellNumbers returns str with numbers spelled out as words by the
+// DefaultSpeller, or the speller provided (e.g. "2fa" becomes "two fa").
+// Ordinals are spelled out as such (e.g. "1st" becomes "first").
+//
+// The words are separated from each other and from adjacent letters by
+// spaces, so the result can be converted to any case (e.g. ToCamel).
+//
+// Numbers are runs of the ASCII digits 0-9. Runs which begin with 0 or which
+// are too large for a uint64 are spelled out digit by digit (e.g. "007"
+// becomes "zero zero seven").
+//
+// If mode is SpellModeNotSpecified, SpellModeLeading is used.
+//
+//	caps.SpellNumbers("3d_model", caps.SpellModeLeading) // three d_model
+//	caps.ToCamel(caps.SpellNumbers("3d_model", caps.SpellModeLeading)) // ThreeDModel
+//	caps.SpellNumbers("1st_place", caps.SpellModeAll) // first_place
+func SpellNumbers[T ~string](str T, mode SpellMode, speller ...Speller) T {
+	sp := DefaultSpeller
+	for _, s := range speller {
+		if s != nil {
+			sp = s
+		}
+	}
+	return T(spellNumbers(string(str), mode, sp))
+}
+
+func spellNumbers(s string, mode SpellMode, speller Speller) string {
+	if mode == SpellModeNotSpecified {
+		mode = SpellModeLeading
+	}
+	var b strings.Builder
+	i := 0
+	prev := rune(-1)
+	// spelled is set if the last output was a spelled out number (e.g. the
+	// ordinal "first" of "1st2nd")
+	spelled := false
+	for i < len(s) {
+		if !isDigitASCII(s[i]) {
+			r, w := utf8.DecodeRuneInString(s[i:])
+			if mode == SpellModeLeading && (unicode.IsLetter(r) || unicode.IsNumber(r)) {
+				// str does not begin with a number
+				b.WriteString(s[i:])
+				return b.String()
+			}
+			b.WriteString(s[i : i+w])
+			prev = r
+			spelled = false
+			i += w
+			continue
+		}
+		start := i
+		for i < len(s) && isDigitASCII(s[i]) {
+			i++
+		}
+		digits := s[start:i]
+		var words string
+		n, err := strconv.ParseUint(digits, 10, 64)
+		if err != nil || (digits[0] == '0' && len(digits) > 1) {
+			spelled := make([]string, len(digits))
+			for j := range digits {
+				spelled[j] = speller.Cardinal(uint64(digits[j] - '0'))
+			}
+			words = strings.Join(spelled, " ")
+		} else if l := speller.OrdinalSuffix(n, s[i:]); l > 0 && !startsWithLetter(s[i+l:]) {
+			words = speller.Ordinal(n)
+			i += l
+		} else {
+			words = speller.Cardinal(n)
+		}
+		if spelled || unicode.IsLetter(prev) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(words)
+		if startsWithLetter(s[i:]) {
+			b.WriteByte(' ')
+		}
+		if mode == SpellModeLeading {
+			b.WriteString(s[i:])
+			return b.String()
+		}
+		prev = -1
+		spelled = true
+	}
+	return b.String()
+}
+
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
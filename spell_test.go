@@ -0,0 +1,160 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chanced/caps"
+	"github.com/chanced/caps/token"
+)
+
+func TestEnglishSpeller(t *testing.T) {
+	tests := []struct {
+		n        uint64
+		cardinal string
+		ordinal  string
+	}{
+		{0, "zero", "zeroth"},
+		{1, "one", "first"},
+		{2, "two", "second"},
+		{3, "three", "third"},
+		{4, "four", "fourth"},
+		{5, "five", "fifth"},
+		{8, "eight", "eighth"},
+		{9, "nine", "ninth"},
+		{11, "eleven", "eleventh"},
+		{12, "twelve", "twelfth"},
+		{13, "thirteen", "thirteenth"},
+		{20, "twenty", "twentieth"},
+		{21, "twenty one", "twenty first"},
+		{42, "forty two", "forty second"},
+		{100, "one hundred", "one hundredth"},
+		{101, "one hundred one", "one hundred first"},
+		{999, "nine hundred ninety nine", "nine hundred ninety ninth"},
+		{1000, "one thousand", "one thousandth"},
+		{1001, "one thousand one", "one thousand first"},
+		{1000000, "one million", "one millionth"},
+		{2500000017, "two billion five hundred million seventeen", "two billion five hundred million seventeenth"},
+		{math.MaxUint64, "eighteen quintillion four hundred forty six quadrillion seven hundred forty four trillion " +
+			"seventy three billion seven hundred nine million five hundred fifty one thousand six hundred fifteen",
+			"eighteen quintillion four hundred forty six quadrillion seven hundred forty four trillion " +
+				"seventy three billion seven hundred nine million five hundred fifty one thousand six hundred fifteenth"},
+	}
+	var speller caps.EnglishSpeller
+	for _, test := range tests {
+		if got := speller.Cardinal(test.n); got != test.cardinal {
+			t.Errorf("Cardinal(%d): expected %q, got %q", test.n, test.cardinal, got)
+		}
+		if got := speller.Ordinal(test.n); got != test.ordinal {
+			t.Errorf("Ordinal(%d): expected %q, got %q", test.n, test.ordinal, got)
+		}
+	}
+}
+
+func TestSpellNumbers(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     caps.SpellMode
+		expected string
+	}{
+		{"", caps.SpellModeAll, ""},
+		{"3d_model", caps.SpellModeNotSpecified, "three d_model"},
+		{"3d_model_2", caps.SpellModeLeading, "three d_model_2"},
+		{"3d_model_2", caps.SpellModeAll, "three d_model_two"},
+		{"model3d", caps.SpellModeAll, "model three d"},
+		{"model3d", caps.SpellModeLeading, "model3d"},
+		{"_2fa", caps.SpellModeLeading, "_two fa"},
+		{"1st_place", caps.SpellModeLeading, "first_place"},
+		{"2ND", caps.SpellModeLeading, "second"},
+		{"11th_hour", caps.SpellModeLeading, "eleventh_hour"},
+		{"1th", caps.SpellModeLeading, "one th"},
+		{"1stly", caps.SpellModeLeading, "one stly"},
+		{"007_agent", caps.SpellModeLeading, "zero zero seven_agent"},
+		{"99999999999999999999", caps.SpellModeAll, strings.TrimSpace(strings.Repeat("nine ", 20))},
+		{"v1.25", caps.SpellModeAll, "v one.twenty five"},
+		{"1st2nd", caps.SpellModeAll, "first second"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := caps.SpellNumbers(test.input, test.mode); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+// digitSpeller spells out numbers digit by digit.
+type digitSpeller struct{ caps.EnglishSpeller }
+
+func (s digitSpeller) Cardinal(n uint64) string {
+	var words []string
+	for _, d := range strconv.FormatUint(n, 10) {
+		words = append(words, s.EnglishSpeller.Cardinal(uint64(d-'0')))
+	}
+	return strings.Join(words, " ")
+}
+
+func TestSpellNumbersConverter(t *testing.T) {
+	converter := caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, token.DefaultCaser, caps.ConverterOpts{
+		SpellNumbers: caps.SpellModeLeading,
+	})
+	opts := caps.WithConverter(converter)
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"3d_model", "ThreeDModel"},
+		{"2fa", "TwoFa"},
+		{"1st_place", "FirstPlace"},
+		{"model_2_id", "Model2ID"},
+	}
+	for _, test := range tests {
+		if got := caps.ToCamel(test.input, opts); got != test.expected {
+			t.Errorf("ToCamel(%q): expected %q, got %q", test.input, test.expected, got)
+		}
+		if got := caps.Compile(opts).ToCamel(test.input); got != test.expected {
+			t.Errorf("Plan.ToCamel(%q): expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+
+	converter = caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, token.DefaultCaser, caps.ConverterOpts{
+		SpellNumbers: caps.SpellModeAll,
+		Speller:      digitSpeller{},
+	})
+	if got := caps.ToSnake("area51_report", caps.WithConverter(converter)); got != "area_five_one_report" {
+		t.Errorf("expected %q, got %q", "area_five_one_report", got)
+	}
+
+	converter = caps.NewConverter(caps.DefaultReplacements, caps.DefaultTokenizer, token.DefaultCaser, caps.ConverterOpts{
+		SpellNumbers: caps.SpellModeAll,
+	})
+	if got := caps.ToCamel("1st2nd", caps.WithConverter(converter)); got != "FirstSecond" {
+		t.Errorf("expected %q, got %q", "FirstSecond", got)
+	}
+}
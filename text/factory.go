@@ -0,0 +1,276 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package text
+
+import "github.com/chanced/caps"
+
+// Factory creates BoundTexts which are converted with a caps.Caps instance,
+// allowing a custom Caser, Tokenizer, or Replacements.
+type Factory struct {
+	caps caps.Caps
+}
+
+// With returns a Factory which creates BoundTexts that are converted by c.
+//
+//	turkish := text.With(caps.New(caps.Config{Caser: token.TurkishCaser}))
+//	turkish.Text("istanbul_sehir").ToCamel() // İstanbulSehir
+func With(c caps.Caps) Factory {
+	return Factory{caps: c}
+}
+
+// Caps returns the caps.Caps of f.
+func (f Factory) Caps() caps.Caps {
+	return f.Text("").Caps()
+}
+
+// Text returns t bound to the caps.Caps of f.
+func (f Factory) Text(t Text) BoundText {
+	return BoundText{Text: t, caps: f.caps}
+}
+
+// Texts returns each Text of t bound to the caps.Caps of f.
+func (f Factory) Texts(t Texts) []BoundText {
+	if t == nil {
+		return nil
+	}
+	res := make([]BoundText, len(t))
+	for i, v := range t {
+		res[i] = f.Text(v)
+	}
+	return res
+}
+
+// BoundText is a Text whose case conversion methods use a caps.Caps instance
+// rather than caps.Opts. BoundTexts are created with a Factory.
+//
+// The case conversion and word methods of BoundText (e.g. ToCamel, Words,
+// ReplaceWord) use the bound caps.Caps and return BoundTexts, or Texts if
+// they return more than one value. All other methods are those of Text and
+// return Text.
+//
+// The zero value uses caps.New().
+type BoundText struct {
+	Text
+	caps caps.Caps
+}
+
+func (t BoundText) c() caps.Caps {
+	if t.caps.Converter() == nil {
+		return caps.New()
+	}
+	return t.caps
+}
+
+func (t BoundText) bind(s string) BoundText {
+	return BoundText{Text: Text(s), caps: t.caps}
+}
+
+// opts returns the options of the caps.Caps of t for the word methods of
+// Text.
+func (t BoundText) opts() []caps.Opts {
+	c := t.c()
+	return []caps.Opts{{
+		AllowedSymbols: c.AllowedSymbols(),
+		Converter:      c.Converter(),
+		ReplaceStyle:   c.ReplaceStyle(),
+		NumberRules:    c.NumberRules(),
+	}}
+}
+
+// Caps returns the caps.Caps which t is bound to.
+func (t BoundText) Caps() caps.Caps {
+	return t.c()
+}
+
+// UpperFirst converts the first rune of t to upper case.
+func (t BoundText) UpperFirst() BoundText {
+	return t.bind(t.c().UpperFirst(t.String()))
+}
+
+// LowerFirst converts the first rune of t to lower case.
+func (t BoundText) LowerFirst() BoundText {
+	return t.bind(t.c().LowerFirst(t.String()))
+}
+
+// ToCamel transforms the case of t into Camel Case (e.g. AnExampleString).
+func (t BoundText) ToCamel() BoundText {
+	return t.bind(t.c().ToCamel(t.String()))
+}
+
+// ToLowerCamel transforms the case of t into Lower Camel Case (e.g.
+// anExampleString).
+func (t BoundText) ToLowerCamel() BoundText {
+	return t.bind(t.c().ToLowerCamel(t.String()))
+}
+
+// ToSnake transforms the case of t into Lower Snake Case (e.g.
+// an_example_string).
+func (t BoundText) ToSnake() BoundText {
+	return t.bind(t.c().ToSnake(t.String()))
+}
+
+// ToScreamingSnake transforms the case of t into Screaming Snake Case (e.g.
+// AN_EXAMPLE_STRING).
+func (t BoundText) ToScreamingSnake() BoundText {
+	return t.bind(t.c().ToScreamingSnake(t.String()))
+}
+
+// ToKebab transforms the case of t into Lower Kebab Case (e.g.
+// an-example-string).
+func (t BoundText) ToKebab() BoundText {
+	return t.bind(t.c().ToKebab(t.String()))
+}
+
+// ToScreamingKebab transforms the case of t into Screaming Kebab Case (e.g.
+// AN-EXAMPLE-STRING).
+func (t BoundText) ToScreamingKebab() BoundText {
+	return t.bind(t.c().ToScreamingKebab(t.String()))
+}
+
+// ToDotNotation transforms the case of t into Lower Dot Notation Case (e.g.
+// an.example.string).
+func (t BoundText) ToDotNotation() BoundText {
+	return t.bind(t.c().ToDotNotation(t.String()))
+}
+
+// ToScreamingDotNotation transforms the case of t into Screaming Dot Notation
+// Case (e.g. AN.EXAMPLE.STRING).
+func (t BoundText) ToScreamingDotNotation() BoundText {
+	return t.bind(t.c().ToScreamingDotNotation(t.String()))
+}
+
+// ToTitle transforms the case of t into Title Case (e.g. An Example String).
+func (t BoundText) ToTitle() BoundText {
+	return t.bind(t.c().ToTitle(t.String()))
+}
+
+// ToSentence transforms the case of t into Sentence case (e.g. An example
+// string).
+func (t BoundText) ToSentence() BoundText {
+	return t.bind(t.c().ToSentence(t.String()))
+}
+
+// ToSlug transforms t into an ASCII slug suitable for URLs and identifiers
+// (e.g. an-example-string).
+func (t BoundText) ToSlug(opts ...caps.SlugOpts) BoundText {
+	return t.bind(t.c().ToSlug(t.String(), opts...))
+}
+
+// ToDelimited transforms the case of t into Text separated by delimiter.
+//
+// If lowercase is false, the output will be all uppercase.
+func (t BoundText) ToDelimited(delimiter Text, lowercase bool) BoundText {
+	return t.bind(t.c().ToDelimited(t.String(), delimiter.String(), lowercase))
+}
+
+// ToConvention transforms t into the naming convention cv.
+func (t BoundText) ToConvention(cv caps.Convention) BoundText {
+	return t.bind(t.c().ToConvention(t.String(), cv))
+}
+
+// NormalizeKey returns the normalized identifier of t.
+//
+// See caps.NormalizeKey for more information.
+func (t BoundText) NormalizeKey() BoundText {
+	return t.bind(t.c().NormalizeKey(t.String()))
+}
+
+// EqualIdent reports whether t and other name the same identifier,
+// regardless of their convention and case.
+func (t BoundText) EqualIdent(other Text) bool {
+	return t.c().EqualIdent(t.String(), other.String())
+}
+
+// TruncateWords returns t up to the end of its nth word, as split by the
+// bound caps.Caps, followed by ellipsis if t has more than n words.
+//
+// See Text.TruncateWords for more information.
+func (t BoundText) TruncateWords(n int, ellipsis Text) BoundText {
+	return t.bind(t.Text.TruncateWords(n, ellipsis, t.opts()...).String())
+}
+
+// Abbreviate shortens t to at most maxWidth columns, cutting at the words
+// split by the bound caps.Caps where possible.
+//
+// See Text.Abbreviate for more information.
+func (t BoundText) Abbreviate(maxWidth int) BoundText {
+	return t.bind(t.Text.Abbreviate(maxWidth, t.opts()...).String())
+}
+
+// Wrap splits t into lines of at most width columns, breaking between the
+// words split by the bound caps.Caps.
+//
+// See Text.Wrap for more information.
+func (t BoundText) Wrap(width int) Texts {
+	return t.Text.Wrap(width, t.opts()...)
+}
+
+// Words returns the words of t, as split by the bound caps.Caps, as they
+// appear in t.
+func (t BoundText) Words() Texts {
+	return t.Text.Words(t.opts()...)
+}
+
+// FirstWord returns the first word of t, as split by the bound caps.Caps.
+func (t BoundText) FirstWord() BoundText {
+	return t.bind(t.Text.FirstWord(t.opts()...).String())
+}
+
+// LastWord returns the last word of t, as split by the bound caps.Caps.
+func (t BoundText) LastWord() BoundText {
+	return t.bind(t.Text.LastWord(t.opts()...).String())
+}
+
+// InsertWord returns t with w inserted as the ith word, as split by the bound
+// caps.Caps.
+//
+// See Text.InsertWord for more information.
+func (t BoundText) InsertWord(i int, w Text) BoundText {
+	return t.bind(t.Text.InsertWord(i, w, t.opts()...).String())
+}
+
+// ReplaceWord returns t with each word, as split by the bound caps.Caps,
+// equal to old under simple case folding replaced by new.
+//
+// See Text.ReplaceWord for more information.
+func (t BoundText) ReplaceWord(old, new Text) BoundText {
+	return t.bind(t.Text.ReplaceWord(old, new, t.opts()...).String())
+}
+
+// RemoveWord returns t without the words, as split by the bound caps.Caps,
+// equal to w under simple case folding.
+//
+// See Text.RemoveWord for more information.
+func (t BoundText) RemoveWord(w Text) BoundText {
+	return t.bind(t.Text.RemoveWord(w, t.opts()...).String())
+}
+
+// ReverseWords returns t with the order of its words, as split by the bound
+// caps.Caps, reversed.
+//
+// See Text.ReverseWords for more information.
+func (t BoundText) ReverseWords() BoundText {
+	return t.bind(t.Text.ReverseWords(t.opts()...).String())
+}
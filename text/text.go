@@ -60,6 +60,9 @@ func (t Texts) TotalLen() int {
 
 // Join returns a new Text with each Text in t joined by sep.
 func (t Texts) Join(sep Text) Text {
+	if len(t) == 0 {
+		return ""
+	}
 	var b strings.Builder
 	b.Grow(t.TotalLen() + len(sep)*(len(t)-1))
 	for i, v := range t {
@@ -94,6 +97,163 @@ func (t Texts) ContainsFold(val Text) bool {
 	return false
 }
 
+// Map returns a new Texts with the result of fn for each Text in t.
+func (t Texts) Map(fn func(Text) Text) Texts {
+	if t == nil {
+		return nil
+	}
+	res := make(Texts, len(t))
+	for i, v := range t {
+		res[i] = fn(v)
+	}
+	return res
+}
+
+// Filter returns a new Texts with each Text in t for which fn returns true.
+func (t Texts) Filter(fn func(Text) bool) Texts {
+	var res Texts
+	for _, v := range t {
+		if fn(v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Unique returns a new Texts with the first occurrence of each Text in t.
+func (t Texts) Unique() Texts {
+	seen := make(map[Text]struct{}, len(t))
+	return t.Filter(func(v Text) bool {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+		return true
+	})
+}
+
+// UniqueFold returns a new Texts with the first occurrence of each identifier
+// in t, where Texts which name the same identifier in different conventions
+// (e.g. "user_id", "UserID" and "USER-ID") are considered duplicates.
+//
+// See caps.NormalizeKey for more information.
+func (t Texts) UniqueFold(opts ...caps.Opts) Texts {
+	seen := make(map[Text]struct{}, len(t))
+	return t.Filter(func(v Text) bool {
+		key := v.NormalizeKey(opts...)
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+		return true
+	})
+}
+
+// GroupByConvention returns the Texts of t grouped by their detected naming
+// convention. Texts which do not follow a convention are grouped under
+// caps.ConventionUnknown.
+//
+// See caps.DetectConvention for more information.
+func (t Texts) GroupByConvention() map[caps.Convention]Texts {
+	res := make(map[caps.Convention]Texts)
+	for _, v := range t {
+		c := v.Convention()
+		res[c] = append(res[c], v)
+	}
+	return res
+}
+
+// NaturalLess reports whether the element with index i must sort before the
+// element with index j in natural order, where runs of digits are compared
+// by their numeric value (e.g. "item2" before "item10").
+func (t Texts) NaturalLess(i int, j int) bool { return naturalLess(t[i], t[j]) }
+
+// SortNatural returns a copy of t sorted in natural order. The order of
+// equal elements is preserved.
+//
+// See NaturalLess for more information.
+func (t Texts) SortNatural() Texts {
+	if t == nil {
+		return nil
+	}
+	res := make(Texts, len(t))
+	copy(res, t)
+	sort.SliceStable(res, res.NaturalLess)
+	return res
+}
+
+// ToCamel returns a new Texts with each Text in t transformed into Camel Case
+// (e.g. AnExampleString).
+//
+// See Text.ToCamel for more information.
+func (t Texts) ToCamel(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToCamel(opts...) })
+}
+
+// ToLowerCamel returns a new Texts with each Text in t transformed into Lower
+// Camel Case (e.g. anExampleString).
+//
+// See Text.ToLowerCamel for more information.
+func (t Texts) ToLowerCamel(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToLowerCamel(opts...) })
+}
+
+// ToSnake returns a new Texts with each Text in t transformed into Lower Snake
+// Case (e.g. an_example_string).
+func (t Texts) ToSnake(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToSnake(opts...) })
+}
+
+// ToScreamingSnake returns a new Texts with each Text in t transformed into
+// Screaming Snake Case (e.g. AN_EXAMPLE_STRING).
+func (t Texts) ToScreamingSnake(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToScreamingSnake(opts...) })
+}
+
+// ToKebab returns a new Texts with each Text in t transformed into Lower Kebab
+// Case (e.g. an-example-string).
+func (t Texts) ToKebab(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToKebab(opts...) })
+}
+
+// ToScreamingKebab returns a new Texts with each Text in t transformed into
+// Screaming Kebab Case (e.g. AN-EXAMPLE-STRING).
+func (t Texts) ToScreamingKebab(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToScreamingKebab(opts...) })
+}
+
+// ToDotNotation returns a new Texts with each Text in t transformed into Lower
+// Dot Notation Case (e.g. an.example.string).
+func (t Texts) ToDotNotation(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToDotNotation(opts...) })
+}
+
+// ToScreamingDotNotation returns a new Texts with each Text in t transformed
+// into Screaming Dot Notation Case (e.g. AN.EXAMPLE.STRING).
+func (t Texts) ToScreamingDotNotation(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToScreamingDotNotation(opts...) })
+}
+
+// ToTitle returns a new Texts with each Text in t transformed into Title Case
+// (e.g. An Example String).
+func (t Texts) ToTitle(opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToTitle(opts...) })
+}
+
+// ToDelimited returns a new Texts with each Text in t transformed into Text
+// separated by delimiter.
+//
+// If lowercase is false, the output will be all uppercase.
+func (t Texts) ToDelimited(delimiter Text, lowercase bool, opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return v.ToDelimited(delimiter, lowercase, opts...) })
+}
+
+// ToConvention returns a new Texts with each Text in t transformed into the
+// naming convention c.
+func (t Texts) ToConvention(c caps.Convention, opts ...caps.Opts) Texts {
+	return t.Map(func(v Text) Text { return caps.ToConvention(v, c, opts...) })
+}
+
 type Text string
 
 func (t Text) String() string {
@@ -163,6 +323,13 @@ func (t Text) ToScreamingKebab(opts ...caps.Opts) Text {
 	return caps.ToScreamingKebab(t, opts...)
 }
 
+// ToSlug transforms t into an ASCII slug suitable for URLs and identifiers
+// (e.g. an-example-string) using either the provided Converter or the
+// DefaultConverter otherwise.
+func (t Text) ToSlug(opts ...caps.SlugOpts) Text {
+	return caps.ToSlug(t, opts...)
+}
+
 // ToDotNotation transforms the case of the Text t into Lower Dot Notation Case (e.g. an.example.string) using
 // either the provided Converter or the DefaultConverter otherwise.
 func (t Text) ToDotNotation(opts ...caps.Opts) Text {
@@ -190,6 +357,26 @@ func (t Text) ToTitle(opts ...caps.Opts) Text {
 	return caps.ToTitle(t, opts...)
 }
 
+// ToSentence transforms the case of t into Sentence case (e.g. An example
+// string) using either the provided Converter or the DefaultConverter
+// otherwise.
+func (t Text) ToSentence(opts ...caps.Opts) Text {
+	return caps.ToSentence(t, opts...)
+}
+
+// NormalizeKey returns the normalized identifier of t, which is shared by
+// Texts naming the same identifier in different conventions (e.g. "user_id",
+// "UserID" and "USER-ID").
+func (t Text) NormalizeKey(opts ...caps.Opts) Text {
+	return caps.NormalizeKey(t, opts...)
+}
+
+// Convention returns the naming convention of t or caps.ConventionUnknown if
+// t does not follow one.
+func (t Text) Convention() caps.Convention {
+	return caps.DetectConvention(t)
+}
+
 // ToDelimited transforms the case of t into Text separated by delimiter,
 // using either the provided Converter or the DefaultConverter otherwise.
 //
@@ -526,6 +713,46 @@ func Pointer(t Text) *Text {
 	return &t
 }
 
+// naturalLess reports whether a sorts before b, comparing runs of ASCII
+// digits by their numeric value. If the values of two runs are equal, the run
+// with fewer leading zeros sorts first.
+func naturalLess(a, b Text) bool {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if !isDigit(a[i]) || !isDigit(b[j]) {
+			if a[i] != b[j] {
+				return a[i] < b[j]
+			}
+			i++
+			j++
+			continue
+		}
+		si, sj := i, j
+		for i < len(a) && isDigit(a[i]) {
+			i++
+		}
+		for j < len(b) && isDigit(b[j]) {
+			j++
+		}
+		x := strings.TrimLeft(string(a[si:i]), "0")
+		y := strings.TrimLeft(string(b[sj:j]), "0")
+		if len(x) != len(y) {
+			return len(x) < len(y)
+		}
+		if x != y {
+			return x < y
+		}
+		if i-si != j-sj {
+			return i-si < j-sj
+		}
+	}
+	return len(a)-i < len(b)-j
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
 func collect(slice []string) Texts {
 	res := make([]Text, len(slice))
 	for i, v := range slice {
@@ -150,6 +150,7 @@ func TestTexts_Join(t *testing.T) {
 		want Text
 	}{
 		{"empty", Texts{}, args{""}, ""},
+		{"empty sep", Texts{}, args{" "}, ""},
 		{"one", Texts{"a"}, args{""}, "a"},
 		{"two", Texts{"a", "b"}, args{""}, "ab"},
 		{"sep", Texts{"a", "b"}, args{" "}, "a b"},
@@ -164,6 +165,142 @@ func TestTexts_Join(t *testing.T) {
 	}
 }
 
+func TestTexts_Map(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Texts
+		want Texts
+	}{
+		{"nil", nil, nil},
+		{"upper", Texts{"a", "b"}, Texts{"A", "B"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.Map(func(v Text) Text { return v.ToUpper() }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Texts.Map() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTexts_Filter(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Texts
+		want Texts
+	}{
+		{"none", Texts{"b"}, nil},
+		{"some", Texts{"id", "name", "idx"}, Texts{"id", "idx"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.Filter(func(v Text) bool { return v.HasPrefix("id") }); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Texts.Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTexts_Unique(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Texts
+		want Texts
+	}{
+		{"empty", Texts{}, nil},
+		{"unique", Texts{"a", "b"}, Texts{"a", "b"}},
+		{"duplicates", Texts{"b", "a", "b", "A", "a"}, Texts{"b", "a", "A"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.Unique(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Texts.Unique() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTexts_UniqueFold(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Texts
+		want Texts
+	}{
+		{"conventions", Texts{"user_id", "UserID", "userId", "USER-ID", "user_name"}, Texts{"user_id", "user_name"}},
+		{"distinct", Texts{"user_id", "userid"}, Texts{"user_id", "userid"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.UniqueFold(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Texts.UniqueFold() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTexts_GroupByConvention(t *testing.T) {
+	tr := Texts{"user_id", "UserName", "created_at", "USER_ID", "user id"}
+	want := map[caps.Convention]Texts{
+		caps.ConventionSnake:          {"user_id", "created_at"},
+		caps.ConventionCamel:          {"UserName"},
+		caps.ConventionScreamingSnake: {"USER_ID"},
+		caps.ConventionUnknown:        {"user id"},
+	}
+	if got := tr.GroupByConvention(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Texts.GroupByConvention() = %v, want %v", got, want)
+	}
+}
+
+func TestTexts_SortNatural(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Texts
+		want Texts
+	}{
+		{"nil", nil, nil},
+		{"numbers", Texts{"item10", "item2", "item1"}, Texts{"item1", "item2", "item10"}},
+		{"prefix", Texts{"item", "item2", "ite"}, Texts{"ite", "item", "item2"}},
+		{"leading zeros", Texts{"v010", "v10", "v9", "v09"}, Texts{"v9", "v09", "v10", "v010"}},
+		{"multiple runs", Texts{"a2b10", "a2b9", "a10b1", "a2"}, Texts{"a2", "a2b9", "a2b10", "a10b1"}},
+		{"text", Texts{"b", "a1", "A1"}, Texts{"A1", "a1", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := append(Texts(nil), tt.tr...)
+			if got := tt.tr.SortNatural(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Texts.SortNatural() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.tr, orig) {
+				t.Errorf("Texts.SortNatural() modified t: %v", tt.tr)
+			}
+		})
+	}
+}
+
+func TestTexts_ToCamel(t *testing.T) {
+	tr := Texts{"user_id", "created-at"}
+	want := Texts{"UserID", "CreatedAt"}
+	if got := tr.ToCamel(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Texts.ToCamel() = %v, want %v", got, want)
+	}
+}
+
+func TestTexts_ToSnake(t *testing.T) {
+	tr := Texts{"UserID", "createdAt", "$price"}
+	want := Texts{"user_id", "created_at", "$price"}
+	if got := tr.ToSnake(caps.WithAllowedSymbols("$")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Texts.ToSnake() = %v, want %v", got, want)
+	}
+}
+
+func TestTexts_ToConvention(t *testing.T) {
+	tr := Texts{"UserID", "created_at"}
+	want := Texts{"USER-ID", "CREATED-AT"}
+	if got := tr.ToConvention(caps.ConventionScreamingKebab); !reflect.DeepEqual(got, want) {
+		t.Errorf("Texts.ToConvention() = %v, want %v", got, want)
+	}
+}
+
 func TestText_ToLower(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1461,3 +1598,298 @@ func TestPointer(t *testing.T) {
 		t.Error("Pointer failed")
 	}
 }
+
+func TestFactory(t *testing.T) {
+	f := With(caps.New(caps.Config{
+		Replacements: []caps.Replacement{{Camel: "Sku", Screaming: "SKU"}},
+		Caser:        token.TurkishCaser,
+	}))
+	tests := []struct {
+		name string
+		got  BoundText
+		want Text
+	}{
+		{"replacement", f.Text("product_sku").ToCamel(), "ProductSKU"},
+		{"no default replacements", f.Text("user_url").ToCamel(), "UserUrl"},
+		{"turkish", f.Text("istanbul_sku").ToCamel(), "İstanbulSKU"},
+		{"chained", f.Text("productSKU").ToSnake().ToScreamingKebab(), "PRODUCT-SKU"},
+		{"convention", f.Text("product_sku").ToConvention(caps.ConventionLowerCamel), "productSKU"},
+		{"zero value", BoundText{Text: "user_id"}.ToCamel(), "UserID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Text != tt.want {
+				t.Errorf("BoundText = %v, want %v", tt.got.Text, tt.want)
+			}
+		})
+	}
+	if got := f.Text("productSku").ToSnake().Contains("sku"); !got {
+		t.Errorf("BoundText.Contains() = %v, want %v", got, true)
+	}
+	if got := f.Texts(Texts{"a", "b"}); len(got) != 2 || got[1].Text != "b" {
+		t.Errorf("Factory.Texts() = %v", got)
+	}
+	if !f.Text("ProductSKU").EqualIdent("product_sku") {
+		t.Errorf("BoundText.EqualIdent() = false, want true")
+	}
+}
+
+func TestBoundText_Words(t *testing.T) {
+	f := With(caps.New(caps.Config{
+		Replacements: []caps.Replacement{{Camel: "Sku", Screaming: "SKU"}, {Camel: "Skuid", Screaming: "SKUID"}},
+	}))
+	tests := []struct {
+		name string
+		got  BoundText
+		want Text
+	}{
+		{"truncate", f.Text("SKUIDProductName").TruncateWords(1, "…"), "SKUID…"},
+		{"abbreviate", f.Text("SKUIDProductName").Abbreviate(6), "SKUID…"},
+		{"first", f.Text("SKUIDProductName").FirstWord(), "SKUID"},
+		{"last", f.Text("productSKUID").LastWord(), "SKUID"},
+		{"insert", f.Text("product_skuid").InsertWord(1, "old"), "product_old_skuid"},
+		{"replace", f.Text("productSKUID").ReplaceWord("skuid", "sku"), "productSKU"},
+		{"remove", f.Text("SKUIDProductName").RemoveWord("skuid"), "ProductName"},
+		{"reverse", f.Text("productSKUID").ReverseWords(), "skuidProduct"},
+		{"chained", f.Text("product_skuid").ReplaceWord("skuid", "sku").ToCamel(), "ProductSKU"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Text != tt.want {
+				t.Errorf("BoundText = %v, want %v", tt.got.Text, tt.want)
+			}
+		})
+	}
+	if got, want := f.Text("SKUIDProductName").Words(), (Texts{"SKUID", "Product", "Name"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("BoundText.Words() = %v, want %v", got, want)
+	}
+	if got, want := f.Text("SKUID product name").Wrap(13), (Texts{"SKUID product", "name"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("BoundText.Wrap() = %v, want %v", got, want)
+	}
+	if got, want := Text("SKUIDProductName").Words(), (Texts{"S", "K", "UID", "Product", "Name"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Text.Words() = %v, want %v", got, want)
+	}
+}
+
+func TestText_TruncateWords(t *testing.T) {
+	type args struct {
+		n        int
+		ellipsis Text
+	}
+	tests := []struct {
+		name string
+		tr   Text
+		args args
+		want Text
+	}{
+		{"empty", "", args{2, "…"}, ""},
+		{"fits", "user_id", args{2, "…"}, "user_id"},
+		{"replacements", "HTTPServerConfig", args{2, "…"}, "HTTPServer…"},
+		{"one", "HTTPServerConfig", args{1, "…"}, "HTTP…"},
+		{"zero", "HTTPServerConfig", args{0, "…"}, "…"},
+		{"punctuation", "the quick, brown fox", args{2, "..."}, "the quick..."},
+		{"snake", "user_account_status", args{2, "_…"}, "user_account_…"},
+		{"combining mark", "cafe\u0301 au lait", args{1, "…"}, "cafe\u0301…"},
+		{"caseless", "用户 ID 列表", args{1, "…"}, "用户…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []caps.Opts
+			if tt.name == "caseless" {
+				opts = append(opts, caps.WithConverter(caps.NewConverter(caps.DefaultReplacements, caps.NewTokenizer(caps.DEFAULT_DELIMITERS, nil, caps.TokenizerOpts{KeepCaseless: true}), nil)))
+			}
+			if got := tt.tr.TruncateWords(tt.args.n, tt.args.ellipsis, opts...); got != tt.want {
+				t.Errorf("Text.TruncateWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_Abbreviate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tr       Text
+		maxWidth int
+		want     Text
+	}{
+		{"fits", "HTTPServerConfig", 16, "HTTPServerConfig"},
+		{"words", "HTTPServerConfig", 15, "HTTPServer…"},
+		{"first word", "HTTPServerConfig", 5, "HTTP…"},
+		{"cut word", "HTTPServerConfig", 4, "HTT…"},
+		{"ellipsis only", "HTTPServerConfig", 1, "…"},
+		{"too narrow", "HTTPServerConfig", 0, ""},
+		{"wide", "ＡＢＣ_ＤＥＦ", 10, "ＡＢＣ…"},
+		{"wide cut", "ＡＢＣ", 4, "Ａ…"},
+		{"grapheme", "e\u0301e\u0301e\u0301e\u0301", 3, "e\u0301e\u0301…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.Abbreviate(tt.maxWidth); got != tt.want {
+				t.Errorf("Text.Abbreviate() = %v, want %v", got, tt.want)
+			}
+			if got := tt.tr.Abbreviate(tt.maxWidth); got.Width() > tt.maxWidth && tt.maxWidth > 0 {
+				t.Errorf("Text.Abbreviate() width = %d, want <= %d", got.Width(), tt.maxWidth)
+			}
+		})
+	}
+}
+
+func TestText_Wrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		tr    Text
+		width int
+		want  Texts
+	}{
+		{"empty", "", 10, nil},
+		{"no width", "user_account_status", 0, Texts{"user_account_status"}},
+		{"fits", "user_account_status", 19, Texts{"user_account_status"}},
+		{"snake", "user_account_status", 8, Texts{"user_", "account_", "status"}},
+		{"camel", "HTTPServerConfig", 10, Texts{"HTTPServer", "Config"}},
+		{"spaces", "the quick brown fox", 9, Texts{"the quick", "brown fox"}},
+		{"leading space", "  the quick", 5, Texts{"  the", "quick"}},
+		{"long word", "internationalization is long", 8, Texts{"internat", "ionaliza", "tion is", "long"}},
+		{"wide", "用户 列表 名称", 5, Texts{"用户", "列表", "名称"}},
+		{"wide narrow", "ＡＢＣ", 3, Texts{"Ａ", "Ｂ", "Ｃ"}},
+		{"wider than width", "ＡＢ", 1, Texts{"Ａ", "Ｂ"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []caps.Opts
+			if tt.name == "wide" {
+				opts = append(opts, caps.WithConverter(caps.NewConverter(caps.DefaultReplacements, caps.NewTokenizer(caps.DEFAULT_DELIMITERS, nil, caps.TokenizerOpts{KeepCaseless: true}), nil)))
+			}
+			if got := tt.tr.Wrap(tt.width, opts...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Text.Wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_Words(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Text
+		want Texts
+	}{
+		{"empty", "", nil},
+		{"camel", "HTTPServerConfig", Texts{"HTTP", "Server", "Config"}},
+		{"snake", "user_id_v2", Texts{"user", "id", "v2"}},
+		{"sentence", "  The quick, brown fox!", Texts{"The", "quick", "brown", "fox"}},
+		{"combining mark", "café_au_lait", Texts{"café", "au", "lait"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.Words(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Text.Words() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if got := Text("HTTPServerConfig").FirstWord(); got != "HTTP" {
+		t.Errorf("Text.FirstWord() = %v, want %v", got, "HTTP")
+	}
+	if got := Text("HTTPServerConfig").LastWord(); got != "Config" {
+		t.Errorf("Text.LastWord() = %v, want %v", got, "Config")
+	}
+	if got := Text("").LastWord(); got != "" {
+		t.Errorf("Text.LastWord() = %v, want %v", got, "")
+	}
+}
+
+func TestText_ReplaceWord(t *testing.T) {
+	type args struct {
+		old Text
+		new Text
+	}
+	tests := []struct {
+		name string
+		tr   Text
+		args args
+		want Text
+	}{
+		{"snake", "user_id_v2", args{"id", "uuid"}, "user_uuid_v2"},
+		{"lower camel", "userName", args{"name", "email"}, "userEmail"},
+		{"camel replacement", "UserID", args{"id", "json"}, "UserJSON"},
+		{"screaming kebab", "USER-ID", args{"user", "account"}, "ACCOUNT-ID"},
+		{"all", "id_user_id", args{"ID", "key"}, "key_user_key"},
+		{"missing", "user_id", args{"name", "email"}, "user_id"},
+		{"unknown", "The user id!", args{"id", "name"}, "The user name!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.ReplaceWord(tt.args.old, tt.args.new); got != tt.want {
+				t.Errorf("Text.ReplaceWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_InsertWord(t *testing.T) {
+	type args struct {
+		i int
+		w Text
+	}
+	tests := []struct {
+		name string
+		tr   Text
+		args args
+		want Text
+	}{
+		{"snake", "user_id", args{1, "account"}, "user_account_id"},
+		{"start", "userID", args{-1, "the"}, "theUserID"},
+		{"end", "UserID", args{10, "list"}, "UserIDList"},
+		{"dot notation", "user.id", args{2, "v2"}, "user.id.v2"},
+		{"unknown", "the fox", args{1, "quick"}, "the quick fox"},
+		{"unknown single word", "Fox!", args{0, "quick"}, "quick Fox!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.InsertWord(tt.args.i, tt.args.w); got != tt.want {
+				t.Errorf("Text.InsertWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_RemoveWord(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Text
+		w    Text
+		want Text
+	}{
+		{"snake", "user_account_id", "account", "user_id"},
+		{"camel", "UserAccountID", "account", "UserID"},
+		{"screaming snake", "USER_ACCOUNT_ID", "Account", "USER_ID"},
+		{"unknown", "the quick fox", "quick", "the fox"},
+		{"missing", "user_id", "name", "user_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.RemoveWord(tt.w); got != tt.want {
+				t.Errorf("Text.RemoveWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText_ReverseWords(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Text
+		want Text
+	}{
+		{"empty", "", ""},
+		{"camel", "UserAccountID", "IDAccountUser"},
+		{"lower camel", "userAccountID", "idAccountUser"},
+		{"kebab", "user-account-id", "id-account-user"},
+		{"unknown", "(the quick fox)", "(fox quick the)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.ReverseWords(); got != tt.want {
+				t.Errorf("Text.ReverseWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
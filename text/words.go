@@ -0,0 +1,399 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package text
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/chanced/caps"
+	"github.com/chanced/caps/grapheme"
+)
+
+// Ellipsis is the default ellipsis used by Abbreviate.
+const Ellipsis Text = "…"
+
+// wordSep joins the words of t when tokenizing with the Converter. It can not
+// appear in the output of a conversion unless it is an allowed symbol.
+const wordSep = "\x00"
+
+// span is the byte range of a word within a Text.
+type span struct {
+	start int
+	end   int
+}
+
+// words returns the words of t, as determined by the Converter (i.e. after
+// replacements have been applied, such that "HTTPServer" is made up of
+// "HTTP" and "Server"), along with their positions in t.
+//
+// Spans do not overlap and begin and end on grapheme cluster boundaries.
+func (t Text) words(opts []caps.Opts) (Texts, []span) {
+	joined := caps.ToDelimited(t.String(), wordSep, true, opts...)
+	if len(joined) == 0 {
+		return nil, nil
+	}
+	words := strings.Split(joined, wordSep)
+	spans := make([]span, 0, len(words))
+	bounds := clusterBounds(t.String())
+	pos := 0
+	for _, w := range words {
+		start, end := -1, pos
+		for _, r := range w {
+			i := indexFold(t[end:], r)
+			if i < 0 {
+				// the Converter changed the word; words up to here are kept
+				return collect(words[:len(spans)]), spans
+			}
+			if start < 0 {
+				start = end + i
+			}
+			_, n := utf8.DecodeRuneInString(t[end+i:].String())
+			end += i + n
+		}
+		if start < 0 {
+			continue
+		}
+		start = bounds.before(start)
+		if start < pos {
+			start = pos
+		}
+		end = bounds.after(end)
+		spans = append(spans, span{start: start, end: end})
+		pos = end
+	}
+	return collect(words[:len(spans)]), spans
+}
+
+// indexFold returns the byte index of the first rune of t which is equal to r
+// under simple case folding, or -1.
+func indexFold(t Text, r rune) int {
+	return strings.IndexFunc(t.String(), func(c rune) bool {
+		return c == r || unicode.ToLower(c) == unicode.ToLower(r) || unicode.ToUpper(c) == unicode.ToUpper(r)
+	})
+}
+
+// bounds are the byte offsets of the grapheme cluster boundaries of a string,
+// including 0 and its length.
+type bounds []int
+
+func clusterBounds(s string) bounds {
+	b := bounds{0}
+	n := 0
+	for rest := s; len(rest) > 0; {
+		var c string
+		c, rest = grapheme.Next(rest)
+		n += len(c)
+		b = append(b, n)
+	}
+	return b
+}
+
+// before returns the greatest boundary which is less than or equal to i.
+func (b bounds) before(i int) int {
+	res := 0
+	for _, v := range b {
+		if v > i {
+			break
+		}
+		res = v
+	}
+	return res
+}
+
+// after returns the least boundary which is greater than or equal to i.
+func (b bounds) after(i int) int {
+	for _, v := range b {
+		if v >= i {
+			return v
+		}
+	}
+	return b[len(b)-1]
+}
+
+// Width returns the number of columns t occupies in a monospaced terminal,
+// where East Asian wide characters occupy two columns.
+//
+// See grapheme.Width for more information.
+func (t Text) Width() int {
+	return grapheme.Width(t.String())
+}
+
+// TruncateWords returns t up to the end of its nth word followed by ellipsis
+// if t has more than n words. Otherwise, t is returned unchanged.
+//
+// Words are determined by the Converter, so replacements are not split:
+//
+//	text.Text("HTTPServerConfig").TruncateWords(2, "…") // HTTPServer…
+//	text.Text("the quick, brown fox").TruncateWords(2, "...") // the quick...
+func (t Text) TruncateWords(n int, ellipsis Text, opts ...caps.Opts) Text {
+	_, spans := t.words(opts)
+	if n >= len(spans) {
+		return t
+	}
+	if n <= 0 {
+		return ellipsis
+	}
+	return t[:spans[n-1].end] + ellipsis
+}
+
+// Abbreviate returns t shortened to at most maxWidth columns, as measured by
+// Width. If t is wider than maxWidth, it is cut after the last word which fits
+// and Ellipsis is appended. If the first word does not fit, it is cut at the
+// last grapheme cluster which fits.
+//
+//	text.Text("HTTPServerConfig").Abbreviate(12) // HTTPServer…
+//	text.Text("HTTPServerConfig").Abbreviate(4) // HTT…
+func (t Text) Abbreviate(maxWidth int, opts ...caps.Opts) Text {
+	if t.Width() <= maxWidth {
+		return t
+	}
+	avail := maxWidth - Ellipsis.Width()
+	if avail < 0 {
+		return ""
+	}
+	_, spans := t.words(opts)
+	for i := len(spans) - 1; i >= 0; i-- {
+		if prefix := t[:spans[i].end]; prefix.Width() <= avail {
+			return prefix + Ellipsis
+		}
+	}
+	head, _ := cutWidth(t, avail)
+	return head + Ellipsis
+}
+
+// Wrap splits t into lines which occupy at most width columns, as measured by
+// Width, breaking between words. Delimiters between words remain at the end
+// of a line, except for trailing whitespace which is removed. Words wider than
+// width are broken between grapheme clusters.
+//
+// If width is less than 1, t is returned as the only line.
+//
+//	text.Text("user_account_status").Wrap(8) // [user_ account_ status]
+func (t Text) Wrap(width int, opts ...caps.Opts) Texts {
+	if len(t) == 0 {
+		return nil
+	}
+	if width < 1 {
+		return Texts{t}
+	}
+	_, spans := t.words(opts)
+	// segments are the words of t along with the text which follows them
+	segments := make(Texts, 0, len(spans)+1)
+	prev := 0
+	for i, s := range spans {
+		if i == 0 {
+			continue
+		}
+		segments = append(segments, t[prev:s.start])
+		prev = s.start
+	}
+	segments = append(segments, t[prev:])
+
+	var lines Texts
+	var line Text
+	for _, seg := range segments {
+		if (line + seg).TrimRightFunc(unicode.IsSpace).Width() <= width {
+			line += seg
+			continue
+		}
+		if len(line) > 0 {
+			lines = append(lines, line.TrimRightFunc(unicode.IsSpace))
+		}
+		line = seg.TrimLeftFunc(unicode.IsSpace)
+		for line.TrimRightFunc(unicode.IsSpace).Width() > width {
+			var head Text
+			head, line = cutWidth(line, width)
+			if len(head) == 0 {
+				// a single grapheme cluster is wider than width
+				head, line = Text(grapheme.First(line.String())), line[len(grapheme.First(line.String())):]
+			}
+			lines = append(lines, head)
+		}
+	}
+	if line = line.TrimRightFunc(unicode.IsSpace); len(line) > 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// cutWidth splits t after the last grapheme cluster which fits within width
+// columns.
+func cutWidth(t Text, width int) (head, tail Text) {
+	w, n := 0, 0
+	for rest := t.String(); len(rest) > 0; {
+		var c string
+		c, rest = grapheme.Next(rest)
+		if w += grapheme.ClusterWidth(c); w > width {
+			break
+		}
+		n += len(c)
+	}
+	return t[:n], t[n:]
+}
+
+// Words returns the words of t as they appear in t. Words are determined by
+// the Converter, so replacements are not split.
+//
+//	text.Text("HTTPServerConfig").Words() // [HTTP Server Config]
+//	text.Text("user_id_v2").Words() // [user id v2]
+func (t Text) Words(opts ...caps.Opts) Texts {
+	_, spans := t.words(opts)
+	if len(spans) == 0 {
+		return nil
+	}
+	res := make(Texts, len(spans))
+	for i, s := range spans {
+		res[i] = t[s.start:s.end]
+	}
+	return res
+}
+
+// FirstWord returns the first word of t or an empty Text if t has no words.
+func (t Text) FirstWord(opts ...caps.Opts) Text {
+	words := t.Words(opts...)
+	if len(words) == 0 {
+		return ""
+	}
+	return words[0]
+}
+
+// LastWord returns the last word of t or an empty Text if t has no words.
+func (t Text) LastWord(opts ...caps.Opts) Text {
+	words := t.Words(opts...)
+	if len(words) == 0 {
+		return ""
+	}
+	return words[len(words)-1]
+}
+
+// InsertWord returns t with w inserted as the ith word. If i is out of range,
+// w is inserted at the start or the end of t.
+//
+// See ReplaceWord for information on how the result is rendered.
+//
+//	text.Text("user_id").InsertWord(1, "account") // user_account_id
+func (t Text) InsertWord(i int, w Text, opts ...caps.Opts) Text {
+	return t.editWords(opts, func(words Texts) Texts {
+		if i < 0 {
+			i = 0
+		}
+		if i > len(words) {
+			i = len(words)
+		}
+		res := make(Texts, 0, len(words)+1)
+		res = append(res, words[:i]...)
+		res = append(res, w)
+		return append(res, words[i:]...)
+	})
+}
+
+// ReplaceWord returns t with each word equal to old under simple case folding
+// replaced by new.
+//
+// If t follows a naming convention (see caps.DetectConvention), the result is
+// rendered in that convention. Otherwise, the words are replaced within t and
+// the text between them is kept.
+//
+//	text.Text("user_id_v2").ReplaceWord("id", "uuid") // user_uuid_v2
+//	text.Text("userName").ReplaceWord("name", "email") // userEmail
+func (t Text) ReplaceWord(old, new Text, opts ...caps.Opts) Text {
+	return t.editWords(opts, func(words Texts) Texts {
+		return words.Map(func(v Text) Text {
+			if v.EqualFold(old) {
+				return new
+			}
+			return v
+		})
+	})
+}
+
+// RemoveWord returns t without the words equal to w under simple case
+// folding.
+//
+// See ReplaceWord for information on how the result is rendered.
+//
+//	text.Text("user_account_id").RemoveWord("account") // user_id
+func (t Text) RemoveWord(w Text, opts ...caps.Opts) Text {
+	return t.editWords(opts, func(words Texts) Texts {
+		return words.Filter(func(v Text) bool { return !v.EqualFold(w) })
+	})
+}
+
+// ReverseWords returns t with the order of its words reversed.
+//
+// See ReplaceWord for information on how the result is rendered.
+//
+//	text.Text("UserAccountID").ReverseWords() // IDAccountUser
+func (t Text) ReverseWords(opts ...caps.Opts) Text {
+	return t.editWords(opts, func(words Texts) Texts {
+		res := make(Texts, len(words))
+		for i, v := range words {
+			res[len(words)-1-i] = v
+		}
+		return res
+	})
+}
+
+// editWords returns t with its words replaced by the result of fn, rendered
+// in the naming convention of t if it has one. Otherwise, the words are
+// joined by the text which separated the words of t.
+func (t Text) editWords(opts []caps.Opts, fn func(words Texts) Texts) Text {
+	_, spans := t.words(opts)
+	words := make(Texts, len(spans))
+	for i, s := range spans {
+		words[i] = t[s.start:s.end]
+	}
+	edited := fn(words)
+	if c := t.Convention(); c != caps.ConventionUnknown {
+		return caps.ToConvention(edited.Join(" "), c, opts...)
+	}
+	if len(spans) == 0 {
+		return edited.Join(" ")
+	}
+	seps := make(Texts, 0, len(spans)-1)
+	for i := 1; i < len(spans); i++ {
+		seps = append(seps, t[spans[i-1].end:spans[i].start])
+	}
+	var b strings.Builder
+	b.Grow(len(t) + len(edited.Join(" ")))
+	b.WriteString(t[:spans[0].start].String())
+	for i, v := range edited {
+		if i > 0 {
+			switch {
+			case i-1 < len(seps):
+				b.WriteString(seps[i-1].String())
+			case len(seps) > 0:
+				b.WriteString(seps[len(seps)-1].String())
+			default:
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(v.String())
+	}
+	b.WriteString(t[spans[len(spans)-1].end:].String())
+	return Text(b.String())
+}
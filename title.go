@@ -0,0 +1,177 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/chanced/caps/token"
+)
+
+// TitleStyle is the style guide used by ToTitle to determine which words
+// (articles, conjunctions, and prepositions) are lowercased.
+//
+// Regardless of style, the first word of a title and the first word after a
+// colon are capitalized. Replacements in their screaming form (e.g. "ID") are
+// never lowercased.
+//
+// Colons are kept in the output whenever a TitleStyle is specified, as if ':'
+// were in AllowedSymbols. Hyphenated compounds are only present in the output
+// if '-' is in AllowedSymbols.
+type TitleStyle uint8
+
+const (
+	TitleStyleNotSpecified TitleStyle = iota
+	// Associated Press: articles, and conjunctions and prepositions of three
+	// letters or fewer are lowercased unless they are the last word.
+	TitleStyleAP
+	// Chicago Manual of Style: articles, coordinating conjunctions (except
+	// "so" and "yet"), "as", "to", and all prepositions are lowercased unless
+	// they are the last word.
+	TitleStyleChicago
+	// American Psychological Association: articles, and conjunctions and
+	// prepositions of three letters or fewer are lowercased, including the
+	// last word.
+	TitleStyleAPA
+	// Modern Language Association: articles, coordinating conjunctions, "to",
+	// and all prepositions are lowercased unless they are the last word.
+	TitleStyleMLA
+)
+
+func (ts TitleStyle) String() string {
+	switch ts {
+	case TitleStyleAP:
+		return "TitleStyleAP"
+	case TitleStyleChicago:
+		return "TitleStyleChicago"
+	case TitleStyleAPA:
+		return "TitleStyleAPA"
+	case TitleStyleMLA:
+		return "TitleStyleMLA"
+	}
+	return "TitleStyleNotSpecified"
+}
+
+func newWordSet(words ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		m[w] = struct{}{}
+	}
+	return m
+}
+
+var (
+	titleArticles = []string{"a", "an", "the"}
+
+	titleConjunctions = []string{"and", "as", "but", "for", "if", "nor", "or", "so", "yet"}
+
+	titleShortPrepositions = []string{"as", "at", "by", "for", "in", "of", "off", "on", "out", "per", "to", "up", "via"}
+
+	titlePrepositions = append([]string{
+		"about", "above", "across", "after", "against", "along", "amid", "among",
+		"around", "before", "behind", "below", "beneath", "beside", "besides",
+		"between", "beyond", "down", "during", "except", "from", "inside",
+		"into", "like", "near", "onto", "outside", "over", "past", "since",
+		"than", "through", "throughout", "toward", "towards", "under",
+		"underneath", "until", "upon", "with", "within", "without",
+	}, titleShortPrepositions...)
+
+	titleSmallWords = map[TitleStyle]map[string]struct{}{
+		TitleStyleAP: newWordSet(concatWords(titleArticles, titleConjunctions, titleShortPrepositions)...),
+		TitleStyleChicago: newWordSet(concatWords(titleArticles, titlePrepositions,
+			[]string{"and", "but", "for", "nor", "or"})...),
+		TitleStyleAPA: newWordSet(concatWords(titleArticles, titleConjunctions, titleShortPrepositions)...),
+		TitleStyleMLA: newWordSet(concatWords(titleArticles, titlePrepositions,
+			[]string{"and", "but", "for", "nor", "or", "so", "yet"})...),
+	}
+)
+
+// titleSymbols returns allowedSymbols with ':' added if style is specified so
+// that colons are kept as the boundary after which a word is capitalized.
+func titleSymbols(style TitleStyle, allowedSymbols string) string {
+	if style == TitleStyleNotSpecified || strings.ContainsRune(allowedSymbols, ':') {
+		return allowedSymbols
+	}
+	return allowedSymbols + ":"
+}
+
+func concatWords(lists ...[]string) []string {
+	var res []string
+	for _, l := range lists {
+		res = append(res, l...)
+	}
+	return res
+}
+
+// applyTitleStyle lowercases the small words of title, a string of words
+// joined by spaces which have already been converted to camel case, according
+// to style.
+func applyTitleStyle(caser token.Caser, style TitleStyle, title string) string {
+	small, ok := titleSmallWords[style]
+	if !ok || len(title) == 0 {
+		return title
+	}
+	words := strings.Split(title, " ")
+	capNext := true
+	for i, word := range words {
+		first := capNext
+		last := i == len(words)-1 || strings.HasSuffix(word, ":")
+		capNext = strings.HasSuffix(word, ":")
+		if style == TitleStyleAPA {
+			last = false
+		}
+		parts := strings.Split(word, "-")
+		for j, part := range parts {
+			if len(part) == 0 {
+				continue
+			}
+			switch {
+			case j == 0 && first, j == len(parts)-1 && last:
+				parts[j] = token.UpperFirst(caser, part)
+			case isSmallWord(small, part):
+				parts[j] = token.ToLower(caser, part)
+			default:
+				parts[j] = token.UpperFirst(caser, part)
+			}
+		}
+		words[i] = strings.Join(parts, "-")
+	}
+	return strings.Join(words, " ")
+}
+
+// isSmallWord reports whether word is in small. Words which are entirely
+// uppercase, such as replacements in their screaming form, are not considered
+// small with the exception of single letters (e.g. "A").
+func isSmallWord(small map[string]struct{}, word string) bool {
+	word = strings.TrimRightFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(word) > 1 && !token.HasLower(word) {
+		return false
+	}
+	_, ok := small[strings.ToLower(word)]
+	return ok
+}
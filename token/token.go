@@ -28,6 +28,8 @@ package token
 import (
 	"strings"
 	"unicode"
+
+	"github.com/chanced/caps/grapheme"
 )
 
 // NumberRules are a set of rules for determining if rune r at index of val
@@ -78,6 +80,32 @@ func WriteUpperFirstLowerRest(b *strings.Builder, caser Caser, s string) {
 	}
 }
 
+// WriteUpperFirstGraphemeLowerRest writes the first letter of the first
+// extended grapheme cluster of s as upper case and the rest as lower case
+func WriteUpperFirstGraphemeLowerRest(b *strings.Builder, caser Caser, s string) {
+	if len(s) == 0 {
+		return
+	}
+	first := b.Len() == 0
+	cluster, rest := grapheme.Next(s)
+	mapped := false
+	for _, r := range cluster {
+		switch {
+		case !mapped && unicode.IsLetter(r) && first:
+			b.WriteRune(caser.ToTitle(r))
+			mapped = true
+		case !mapped && unicode.IsLetter(r):
+			b.WriteRune(caser.ToUpper(r))
+			mapped = true
+		default:
+			b.WriteRune(caser.ToLower(r))
+		}
+	}
+	for _, r := range rest {
+		b.WriteRune(caser.ToLower(r))
+	}
+}
+
 // WriteLowerFirstUpperRest writes the first rune as upper case and the rest are
 // separated by sep and written as lower case
 func WriteSplitLowerFirstUpperRest(b *strings.Builder, caser Caser, sep string, s string) {
@@ -301,6 +329,40 @@ func LowerFirst(caser Caser, s string) string {
 	return sb.String()
 }
 
+// UpperFirstGrapheme title cases the first letter of the first extended
+// grapheme cluster of s. The remainder of the cluster (e.g. combining marks)
+// is left intact.
+func UpperFirstGrapheme(caser Caser, s string) string {
+	return mapFirstGrapheme(s, CaserOrDefault(caser).ToTitle)
+}
+
+// LowerFirstGrapheme lower cases the first letter of the first extended
+// grapheme cluster of s. The remainder of the cluster (e.g. combining marks)
+// is left intact.
+func LowerFirstGrapheme(caser Caser, s string) string {
+	return mapFirstGrapheme(s, CaserOrDefault(caser).ToLower)
+}
+
+func mapFirstGrapheme(s string, fn func(r rune) rune) string {
+	if len(s) == 0 {
+		return ""
+	}
+	cluster, rest := grapheme.Next(s)
+	b := strings.Builder{}
+	b.Grow(len(s))
+	mapped := false
+	for _, r := range cluster {
+		if !mapped && unicode.IsLetter(r) {
+			b.WriteRune(fn(r))
+			mapped = true
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(rest)
+	return b.String()
+}
+
 // IsNumber reports true if the string is considered a valid number based on the
 // following rules:
 //
@@ -321,63 +383,103 @@ func LowerFirst(caser Caser, s string) string {
 // - if additionalRules is not nil and the rune is present in the map, the
 // result of the provided func overrides the rules above
 func IsNumber(s string, additionalRules NumberRules) bool {
-	isDec := false
-	var prev rune
-	e := -1
-
 	if len(s) == 0 {
 		return false
 	}
-
+	ns := newNumberScanner(len(s))
 	for i, r := range s {
 		if additionalRules != nil {
 			if fn, ok := additionalRules[r]; ok {
 				if !fn(i, r, s) {
 					return false
 				}
-				prev = r
+				ns.prev = r
 				continue
 			}
 		}
-		if !unicode.IsNumber(r) {
-			switch r {
-			case 'v', 'V', '#':
-				if i > 0 {
-					return false
-				}
-			case '+', '-':
-				if prev > 0 && (!isDec || e != i-1) {
-					return false
-				}
-			case '.':
-				if len(s) == 1 {
-					return false
-				}
-				if i == len(s)-1 {
-					return false
-				}
-				if prev > 0 && !unicode.IsNumber(prev) && prev != '-' && prev != '+' {
-					return false
-				}
-				isDec = true
-			case 'e', 'E':
-				if !isDec {
-					return false
-				}
-				if e != -1 {
-					return false
-				}
-				if i == len(s)-1 {
-					return false
-				}
-				e = i
-			default:
+		if !ns.next(i, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNumberConcat reports whether a followed by b is a number according to
+// IsNumber. a and b are only concatenated if additionalRules is not nil.
+func IsNumberConcat(a, b string, additionalRules NumberRules) bool {
+	if additionalRules != nil {
+		return IsNumber(a+b, additionalRules)
+	}
+	if len(a)+len(b) == 0 {
+		return false
+	}
+	ns := newNumberScanner(len(a) + len(b))
+	for i, r := range a {
+		if !ns.next(i, r) {
+			return false
+		}
+	}
+	for i, r := range b {
+		if !ns.next(len(a)+i, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// numberScanner applies the rules of IsNumber to the runes of a string of
+// n bytes.
+type numberScanner struct {
+	n     int
+	prev  rune
+	isDec bool
+	// e is the index of the exponent or -1
+	e int
+}
+
+func newNumberScanner(n int) numberScanner {
+	return numberScanner{n: n, e: -1}
+}
+
+// next reports whether r, at byte index i, can be part of a number.
+func (ns *numberScanner) next(i int, r rune) bool {
+	if !unicode.IsNumber(r) {
+		switch r {
+		case 'v', 'V', '#':
+			if i > 0 {
+				return false
+			}
+		case '+', '-':
+			if ns.prev > 0 && (!ns.isDec || ns.e != i-1) {
+				return false
+			}
+		case '.':
+			if ns.n == 1 {
+				return false
+			}
+			if i == ns.n-1 {
 				return false
 			}
+			if ns.prev > 0 && !unicode.IsNumber(ns.prev) && ns.prev != '-' && ns.prev != '+' {
+				return false
+			}
+			ns.isDec = true
+		case 'e', 'E':
+			if !ns.isDec {
+				return false
+			}
+			if ns.e != -1 {
+				return false
+			}
+			if i == ns.n-1 {
+				return false
+			}
+			ns.e = i
+		default:
+			return false
 		}
-		prev = r
 	}
-
+	ns.prev = r
 	return true
 }
 
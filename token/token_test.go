@@ -337,6 +337,45 @@ func TestLowerFirst(t *testing.T) {
 	}
 }
 
+func TestUpperFirstGrapheme(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"", ""},
+		{"a", "A"},
+		{"e\u0301clair", "E\u0301clair"},
+		{"\u0600abc", "\u0600Abc"},
+		{"\U0001F44D\U0001F3FDabc", "\U0001F44D\U0001F3FDabc"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			if out := token.UpperFirstGrapheme(token.DefaultCaser, test.in); out != test.out {
+				t.Errorf("expected %+q, got %+q", test.out, out)
+			}
+		})
+	}
+}
+
+func TestLowerFirstGrapheme(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"", ""},
+		{"A", "a"},
+		{"E\u0301CLAIR", "e\u0301CLAIR"},
+		{"\u0600ABC", "\u0600aBC"},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			if out := token.LowerFirstGrapheme(token.DefaultCaser, test.in); out != test.out {
+				t.Errorf("expected %+q, got %+q", test.out, out)
+			}
+		})
+	}
+}
+
 func TestUpperFirst(t *testing.T) {
 	tests := []struct {
 		in  string
@@ -534,6 +573,11 @@ func TestIsNumber(t *testing.T) {
 					t.Errorf("expected \"%s\" to not be a number", test.value)
 				}
 			}
+			for i := 0; i <= len(test.value); i++ {
+				if token.IsNumberConcat(test.value[:i], test.value[i:], test.rules) != test.expected {
+					t.Errorf("expected IsNumberConcat(%q, %q) to return %t", test.value[:i], test.value[i:], test.expected)
+				}
+			}
 		})
 	}
 }
@@ -28,7 +28,9 @@ import (
 	"sort"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
+	"github.com/chanced/caps/grapheme"
 	"github.com/chanced/caps/token"
 )
 
@@ -47,17 +49,101 @@ type Tokenizer interface {
 	Tokenize(value string, allowedSymbols string, numberRules NumberRules) []string
 }
 
+// TokenizerOpts include configurable options for a StdTokenizer.
+//
+// See the documentation for the individual fields for more information.
+type TokenizerOpts struct {
+	// Graphemes enables extended grapheme cluster segmentation (Unicode
+	// Standard Annex #29). When set, each user-perceived character (e.g. "e"
+	// followed by a combining acute accent, emoji ZWJ sequences, or pairs of
+	// regional indicators) is treated as a single unit and is never split
+	// across tokens.
+	//
+	// A grapheme cluster is classified (upper, lower, number, symbol) by its
+	// first letter or number, or its first rune otherwise.
+	//
+	// Default:
+	//  false
+	Graphemes bool
+
+	// Scripts is a set of unicode scripts (e.g. unicode.Latin, unicode.Han).
+	// If set, a change from a letter of one script to a letter of another is
+	// considered a word boundary (e.g. "userпользователь" is tokenized into
+	// ["user", "пользователь"]).
+	//
+	// Letters which do not belong to any of the Scripts (e.g. unicode.Common
+	// letters such as the Katakana-Hiragana prolonged sound mark) continue
+	// the current script.
+	//
+	// DefaultScripts contains a general purpose set.
+	//
+	// Default:
+	//  nil
+	Scripts []*unicode.RangeTable
+
+	// KeepCaseless indicates that runs of caseless letters (e.g. Han, Kana,
+	// Thai, Arabic) should be kept verbatim as tokens. Otherwise, as with
+	// other runes which are neither delimiters nor allowed symbols, they are
+	// dropped.
+	//
+	// Transitions between caseless and cased letters are considered word
+	// boundaries (e.g. "用户ID" is tokenized into ["用户", "ID"]).
+	//
+	// Default:
+	//  false
+	KeepCaseless bool
+}
+
+// DefaultScripts is a general purpose set of scripts which can be used for
+// TokenizerOpts.Scripts.
+var DefaultScripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Greek,
+	unicode.Cyrillic,
+	unicode.Armenian,
+	unicode.Georgian,
+	unicode.Hebrew,
+	unicode.Arabic,
+	unicode.Devanagari,
+	unicode.Thai,
+	unicode.Hangul,
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Katakana,
+}
+
+func loadTokenizerOpts(opts []TokenizerOpts) TokenizerOpts {
+	result := TokenizerOpts{}
+	for _, opt := range opts {
+		if opt.Graphemes {
+			result.Graphemes = true
+		}
+		if opt.Scripts != nil {
+			result.Scripts = append(result.Scripts, opt.Scripts...)
+		}
+		if opt.KeepCaseless {
+			result.KeepCaseless = true
+		}
+	}
+	return result
+}
+
 // NewTokenizer creates and returns a new TokenizerImpl which implements the
 // Tokenizer interface.
 //
 // Tokenizers are used by ConverterImpl to tokenize the input text into
 // token.Tokens that are then formatted.
-func NewTokenizer(delimiters string, caser token.Caser) StdTokenizer {
+func NewTokenizer(delimiters string, caser token.Caser, options ...TokenizerOpts) StdTokenizer {
+	opts := loadTokenizerOpts(options)
 	d := runes(delimiters)
 	sort.Sort(d)
 	return StdTokenizer{
-		delimiters: d,
-		caser:      token.CaserOrDefault(caser),
+		delimiters:      d,
+		asciiDelimiters: newASCIISet(delimiters),
+		caser:           token.CaserOrDefault(caser),
+		graphemes:       opts.Graphemes,
+		scripts:         opts.Scripts,
+		keepCaseless:    opts.KeepCaseless,
 	}
 }
 
@@ -71,8 +157,86 @@ func NewTokenizer(delimiters string, caser token.Caser) StdTokenizer {
 //
 // # Example:
 type StdTokenizer struct {
-	delimiters runes
-	caser      token.Caser
+	delimiters      runes
+	asciiDelimiters asciiSet
+	caser           token.Caser
+	graphemes       bool
+	scripts         []*unicode.RangeTable
+	keepCaseless    bool
+}
+
+// Graphemes reports whether ti segments input by extended grapheme clusters
+// rather than by runes.
+func (ti StdTokenizer) Graphemes() bool {
+	return ti.graphemes
+}
+
+// next returns the next unit of str (either a rune or an extended grapheme
+// cluster), the rune used to classify it, and the number of bytes consumed.
+func (ti StdTokenizer) next(str string) (string, rune, int) {
+	if !ti.graphemes {
+		r, w := utf8.DecodeRuneInString(str)
+		if r == utf8.RuneError && w == 1 {
+			return string(utf8.RuneError), r, w
+		}
+		return str[:w], r, w
+	}
+	cluster := grapheme.First(str)
+	for _, r := range cluster {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return cluster, r, len(cluster)
+		}
+	}
+	r, _ := utf8.DecodeRuneInString(cluster)
+	return cluster, r, len(cluster)
+}
+
+// script returns the index of the script in ti.scripts which r belongs to or
+// -1 if r does not belong to any.
+func (ti StdTokenizer) script(r rune) int {
+	for i, tbl := range ti.scripts {
+		if unicode.Is(tbl, r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isVerbatim reports whether tok is a run of caseless letters which should not
+// be split.
+func (ti StdTokenizer) isVerbatim(tok string) bool {
+	if !ti.keepCaseless {
+		return false
+	}
+	r, ok := token.FirstRune(tok)
+	return ok && isCaseless(r)
+}
+
+// split splits tok into units (either runes or extended grapheme clusters).
+func (ti StdTokenizer) split(tok string) []string {
+	if ti.graphemes {
+		return grapheme.Split(tok)
+	}
+	return strings.Split(tok, "")
+}
+
+// write writes a unit of input, which is either the rune r or, if ti segments
+// graphemes, the cluster unit, to b.
+func (ti StdTokenizer) write(b *strings.Builder, unit string, r rune) {
+	if ti.graphemes {
+		b.WriteString(unit)
+		return
+	}
+	b.WriteRune(r)
+}
+
+// append appends a unit of input, which is either the rune r or, if ti
+// segments graphemes, the cluster unit, to s.
+func (ti StdTokenizer) append(s string, unit string, r rune) string {
+	if ti.graphemes {
+		return token.Append(ti.caser, s, unit)
+	}
+	return token.AppendRune(ti.caser, s, r)
 }
 
 // Tokenize splits a string into a list of token.Tokens based on the case of each
@@ -97,6 +261,38 @@ type StdTokenizer struct {
 //	t := caps.token.Newizer("_")
 //	t.Tokenize("A_SCREAMING_SNAKECASE_VARIABLE", []rune{'_'}) -> ["A_SCREAMING_SNAKECASE_VARIABLE"]
 func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules NumberRules) []string {
+	if size, ok := ti.scanASCII(str); ok {
+		return ti.tokenizeASCII(str, newASCIISet(allowedSymbols), numberRules, size)
+	}
+	return ti.tokenize(str, newRunes(allowedSymbols), numberRules)
+}
+
+// symbolSet is a set of allowed symbols prepared for both tokenize and
+// tokenizeASCII.
+type symbolSet struct {
+	runes runes
+	ascii asciiSet
+}
+
+func newSymbolSet(allowedSymbols string) symbolSet {
+	return symbolSet{
+		runes: newRunes(allowedSymbols),
+		ascii: newASCIISet(allowedSymbols),
+	}
+}
+
+// tokenizeSymbols is Tokenize with allowed symbols which have already been
+// prepared.
+func (ti StdTokenizer) tokenizeSymbols(str string, symbols symbolSet, numberRules NumberRules) []string {
+	if size, ok := ti.scanASCII(str); ok {
+		return ti.tokenizeASCII(str, symbols.ascii, numberRules, size)
+	}
+	return ti.tokenize(str, symbols.runes, numberRules)
+}
+
+// tokenize is the implementation of Tokenize for input which may contain
+// runes outside of the ASCII range.
+func (ti StdTokenizer) tokenize(str string, allowed runes, numberRules NumberRules) []string {
 	var tokens []string
 	var pending []string
 
@@ -114,25 +310,70 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 	current.Reset()
 	defer builderPool.Put(current)
 	prevNumber := false
-	allowed := newRunes(allowedSymbols)
 
-	for i, r := range str {
+	// used to determine script and caseless boundaries
+	segmentScripts := len(ti.scripts) > 0 || ti.keepCaseless
+	prevScript := -1
+	prevClass := letterClassNone
+
+	for i := 0; i < len(str); {
+		// unit is only used when segmenting graphemes; otherwise runes are
+		// written directly
+		var unit string
+		var r rune
+		var w int
+		if ti.graphemes {
+			unit, r, w = ti.next(str[i:])
+		} else {
+			r, w = utf8.DecodeRuneInString(str[i:])
+		}
+		i += w
+		if segmentScripts {
+			class := classifyLetter(r)
+			switch class {
+			case letterClassNone:
+				if !(prevClass == letterClassCaseless && unicode.Is(unicode.Mark, r)) {
+					prevClass = letterClassNone
+				}
+				if ti.delimiters.Contains(r) || unicode.IsSpace(r) {
+					prevScript = -1
+				}
+			default:
+				script := ti.script(r)
+				if current.Len() > 0 && ((ti.keepCaseless && prevClass != letterClassNone && prevClass != class) ||
+					(script >= 0 && prevScript >= 0 && script != prevScript)) {
+					// the current token is complete
+					if foundLower {
+						tokens = append(tokens, pending...)
+						tokens = append(tokens, current.String())
+						pending = nil
+					} else {
+						pending = append(pending, current.String())
+					}
+					current.Reset()
+				}
+				if script >= 0 {
+					prevScript = script
+				}
+				prevClass = class
+			}
+		}
 		switch {
 		case unicode.IsUpper(r):
 			if foundLower && current.Len() > 0 {
 				tokens = append(tokens, current.String())
 				current.Reset()
 			}
-			current.WriteRune(r)
+			ti.write(current, unit, r)
 			prevNumber = false
 		case unicode.IsLower(r):
 			if !foundLower && current.Len() > 0 {
 				// we have to break up the pending first
 				for _, tok := range pending {
-					if token.IsNumber(tok, numberRules) {
+					if token.IsNumber(tok, numberRules) || ti.isVerbatim(tok) {
 						tokens = append(tokens, tok)
 					} else {
-						tokens = append(tokens, strings.Split(tok, "")...)
+						tokens = append(tokens, ti.split(tok)...)
 					}
 				}
 				pending = nil
@@ -141,7 +382,7 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 					tokens = append(tokens, current.String())
 					current.Reset()
 				} else {
-					split := strings.Split(current.String(), "")
+					split := ti.split(current.String())
 					// current becomes the last upper token before discovering the lowercase token
 					current.Reset()
 					current.WriteString(split[len(split)-1])
@@ -150,14 +391,14 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 				}
 			}
 			tokens = append(tokens, pending...)
-			current.WriteRune(r)
+			ti.write(current, unit, r)
 			pending = nil
 			foundLower = true
 		case unicode.IsNumber(r):
 			// if adding the number onto current makes it a valid number
 			// then append this rune to current
-			if token.IsNumber(token.AppendRune(ti.caser, current.String(), r), numberRules) {
-				current.WriteRune(r)
+			if token.IsNumber(ti.append(current.String(), unit, r), numberRules) {
+				ti.write(current, unit, r)
 			} else {
 				// otherwise it is not a number and so we add the current token
 				// to the token or pending list depending on whether or not we
@@ -170,9 +411,12 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 					pending = append(pending, current.String())
 					current.Reset()
 				}
-				current.WriteRune(r)
+				ti.write(current, unit, r)
 			}
 			prevNumber = true
+		case ti.keepCaseless && (isCaseless(r) || (prevClass == letterClassCaseless && unicode.Is(unicode.Mark, r))):
+			ti.write(current, unit, r)
+			prevNumber = false
 		default:
 			if allowed.Contains(r) {
 				if current.Len() > 0 {
@@ -183,14 +427,12 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 						// a number or an 'e' and a number. as such, we have to check if
 						// both this and the next rune (and possibly the rune after
 						// that) make it a number.
-						n := token.AppendRune(ti.caser, current.String(), r)
-
-						runes := []rune(str)
+						n := ti.append(current.String(), unit, r)
 						if token.IsNumber(n, numberRules) {
 							current.Reset()
 							current.WriteString(n)
-						} else if i < len(runes)-1 && canCheckNext(runes[i+1], allowed) {
-							if token.IsNumber(token.AppendRune(ti.caser, n, runes[i+1]), numberRules) {
+						} else if nextUnit, nr, _ := ti.next(str[i:]); i < len(str) && canCheckNext(nr, allowed) {
+							if token.IsNumber(ti.append(n, nextUnit, nr), numberRules) {
 								current.Reset()
 								current.WriteString(n)
 							} else {
@@ -200,7 +442,7 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 									pending = append(pending, current.String())
 								}
 								current.Reset()
-								current.WriteRune(r)
+								ti.write(current, unit, r)
 							}
 						} else {
 							if foundLower {
@@ -209,14 +451,14 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 								pending = append(pending, current.String())
 							}
 							current.Reset()
-							current.WriteRune(r)
+							ti.write(current, unit, r)
 						}
 					} else {
-						current.WriteRune(r)
+						ti.write(current, unit, r)
 					}
 				} else {
 					current.Reset()
-					current.WriteRune(r)
+					ti.write(current, unit, r)
 				}
 			} else if ti.delimiters.Contains(r) || unicode.IsSpace(r) {
 				if current.Len() > 0 {
@@ -246,10 +488,10 @@ func (ti StdTokenizer) Tokenize(str string, allowedSymbols string, numberRules N
 	}
 	if foundLower {
 		for _, tok := range pending {
-			if token.IsNumber(tok, numberRules) {
+			if token.IsNumber(tok, numberRules) || ti.isVerbatim(tok) {
 				tokens = append(tokens, tok)
 			} else {
-				tokens = append(tokens, strings.Split(tok, "")...)
+				tokens = append(tokens, ti.split(tok)...)
 			}
 		}
 		return tokens
@@ -297,6 +539,28 @@ func newRunes(val string) runes {
 	return r
 }
 
+const (
+	letterClassNone = iota
+	letterClassCased
+	letterClassCaseless
+)
+
+func classifyLetter(r rune) int {
+	switch {
+	case !unicode.IsLetter(r):
+		return letterClassNone
+	case isCaseless(r):
+		return letterClassCaseless
+	default:
+		return letterClassCased
+	}
+}
+
+// isCaseless reports whether r is a letter without case (e.g. Han, Thai).
+func isCaseless(r rune) bool {
+	return unicode.IsLetter(r) && !unicode.IsUpper(r) && !unicode.IsLower(r) && !unicode.IsTitle(r)
+}
+
 func canCheckNext(r rune, allowed runes) bool {
 	return unicode.IsNumber(r) || unicode.IsLetter(r) || allowed.Contains(r)
 }
@@ -0,0 +1,336 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"unicode/utf8"
+
+	"github.com/chanced/caps/token"
+)
+
+// asciiSet is a set of ASCII bytes.
+type asciiSet [2]uint64
+
+func newASCIISet(s string) asciiSet {
+	var set asciiSet
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < utf8.RuneSelf {
+			set[c>>6] |= 1 << (c & 63)
+		}
+	}
+	return set
+}
+
+func (s *asciiSet) contains(c byte) bool {
+	return c < utf8.RuneSelf && s[c>>6]&(1<<(c&63)) != 0
+}
+
+// isASCII reports whether s consists solely of ASCII bytes.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func toUpperASCII(c byte) byte {
+	if isLowerASCII(c) {
+		return c - ('a' - 'A')
+	}
+	return c
+}
+
+func toLowerASCII(c byte) byte {
+	if isUpperASCII(c) {
+		return c + ('a' - 'A')
+	}
+	return c
+}
+
+func isUpperASCII(c byte) bool { return 'A' <= c && c <= 'Z' }
+func isLowerASCII(c byte) bool { return 'a' <= c && c <= 'z' }
+func isDigitASCII(c byte) bool { return '0' <= c && c <= '9' }
+
+// isSpaceASCII mirrors unicode.IsSpace for ASCII bytes.
+func isSpaceASCII(c byte) bool {
+	switch c {
+	case '\t', '\n', '\v', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+// asciiToken is the token being built by tokenizeASCII. As long as the bytes
+// of the token are contiguous within str, it is a substring of str and does
+// not allocate.
+type asciiToken struct {
+	str   string
+	start int
+	end   int
+	// buf holds the token once it is no longer contiguous within str (i.e.
+	// a byte which is neither a delimiter nor part of a token was dropped)
+	buf []byte
+	gap bool
+}
+
+// add appends str[i] to the token.
+func (t *asciiToken) add(i int) {
+	switch {
+	case t.gap:
+		t.buf = append(t.buf, t.str[i])
+	case t.start == t.end:
+		t.start, t.end = i, i+1
+	case i == t.end:
+		t.end++
+	default:
+		t.gap = true
+		t.buf = append(append(t.buf[:0], t.str[t.start:t.end]...), t.str[i])
+	}
+}
+
+func (t *asciiToken) len() int {
+	if t.gap {
+		return len(t.buf)
+	}
+	return t.end - t.start
+}
+
+func (t *asciiToken) String() string {
+	if t.gap {
+		return string(t.buf)
+	}
+	return t.str[t.start:t.end]
+}
+
+func (t *asciiToken) reset() {
+	t.start, t.end = 0, 0
+	t.gap = false
+	t.buf = t.buf[:0]
+}
+
+// keepLast removes all but the last byte of the token.
+func (t *asciiToken) keepLast() {
+	if t.gap {
+		t.buf[0] = t.buf[len(t.buf)-1]
+		t.buf = t.buf[:1]
+		return
+	}
+	t.start = t.end - 1
+}
+
+// grow allocates s with a capacity of size if it is nil.
+func grow(s []string, size int) []string {
+	if s == nil {
+		return make([]string, 0, size)
+	}
+	return s
+}
+
+// appendBytes appends each byte of tok to tokens as a separate token.
+func appendBytes(tokens []string, tok string) []string {
+	for i := 0; i < len(tok); i++ {
+		tokens = append(tokens, tok[i:i+1])
+	}
+	return tokens
+}
+
+// tokenizeASCII is the equivalent of tokenize for ASCII input. It classifies
+// bytes with tables rather than unicode lookups and returns substrings of str
+// where possible.
+//
+// Graphemes, Scripts and KeepCaseless have no effect on ASCII input, with the
+// exception of "\r\n" which tokenize treats as a single unit when Graphemes
+// is set.
+//
+// size is the capacity of the returned slice, as estimated by scanASCII.
+func (ti StdTokenizer) tokenizeASCII(str string, allowed asciiSet, numberRules NumberRules, size int) []string {
+	if len(str) == 0 {
+		return nil
+	}
+	// tokens is only allocated once a lowercase letter is found; until then
+	// all tokens are pending
+	var tokens, pending []string
+
+	foundLower := false
+	prevNumber := false
+	current := asciiToken{str: str}
+
+	for i := 0; i < len(str); {
+		p := i
+		c := str[i]
+		i++
+		switch {
+		case isUpperASCII(c):
+			if foundLower && current.len() > 0 {
+				tokens = append(tokens, current.String())
+				current.reset()
+			}
+			current.add(p)
+			prevNumber = false
+		case isLowerASCII(c):
+			if !foundLower {
+				tokens = make([]string, 0, size)
+			}
+			if !foundLower && current.len() > 0 {
+				// we have to break up the pending first
+				for _, tok := range pending {
+					if token.IsNumberConcat(tok, "", numberRules) {
+						tokens = append(tokens, tok)
+					} else {
+						tokens = appendBytes(tokens, tok)
+					}
+				}
+				pending = nil
+				// need to break up the current token if it isn't a number
+				if prevNumber {
+					tokens = append(tokens, current.String())
+					current.reset()
+				} else {
+					// current becomes the last upper byte before discovering
+					// the lowercase byte
+					s := current.String()
+					tokens = appendBytes(tokens, s[:len(s)-1])
+					current.keepLast()
+				}
+			}
+			if len(pending) > 0 {
+				tokens = append(tokens, pending...)
+				pending = nil
+			}
+			current.add(p)
+			foundLower = true
+		case isDigitASCII(c):
+			if token.IsNumberConcat(current.String(), str[p:i], numberRules) {
+				current.add(p)
+			} else {
+				if current.len() > 0 && foundLower {
+					tokens = append(tokens, current.String())
+					current.reset()
+				} else if current.len() > 0 {
+					pending = append(grow(pending, size), current.String())
+					current.reset()
+				}
+				current.add(p)
+			}
+			prevNumber = true
+		case allowed.contains(c):
+			if current.len() == 0 {
+				current.add(p)
+				continue
+			}
+			cur := current.String()
+			if !token.IsNumberConcat(cur, "", numberRules) || token.IsNumberConcat(cur, str[p:i], numberRules) {
+				current.add(p)
+				continue
+			}
+			if i < len(str) {
+				if nc := str[i]; isDigitASCII(nc) || isUpperASCII(nc) || isLowerASCII(nc) || allowed.contains(nc) {
+					if token.IsNumberConcat(cur, str[p:i+1], numberRules) {
+						current.add(p)
+						continue
+					}
+				}
+			}
+			if foundLower {
+				tokens = append(tokens, cur)
+			} else {
+				pending = append(grow(pending, size), cur)
+			}
+			current.reset()
+			current.add(p)
+		case ti.asciiDelimiters.contains(c) || isSpaceASCII(c):
+			if current.len() > 0 {
+				if foundLower {
+					// pending is always empty once a lowercase letter is found
+					tokens = append(tokens, current.String())
+				} else {
+					pending = append(grow(pending, size), current.String())
+				}
+				current.reset()
+			}
+		}
+	}
+	if current.len() > 0 {
+		if foundLower {
+			tokens = append(tokens, current.String())
+		} else {
+			pending = append(grow(pending, size), current.String())
+		}
+	}
+	if foundLower {
+		for _, tok := range pending {
+			if token.IsNumberConcat(tok, "", numberRules) {
+				tokens = append(tokens, tok)
+			} else {
+				tokens = appendBytes(tokens, tok)
+			}
+		}
+		return tokens
+	}
+	return pending
+}
+
+// scanASCII reports whether str can be tokenized by tokenizeASCII (i.e. it
+// consists solely of ASCII bytes and, if ti segments graphemes, does not
+// contain "\r\n") and, if so, returns an estimate of the number of tokens in
+// str used to size the token slice. Each letter of a run of upper case letters
+// which is followed by a lower case letter becomes a token (e.g. "HTTPServer"
+// becomes "H", "T", "T", "P", "Server").
+func (ti StdTokenizer) scanASCII(str string) (int, bool) {
+	n := 1
+	// run is the length of the current run of upper case letters
+	run := 0
+	prevDigit := false
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c >= utf8.RuneSelf || (ti.graphemes && c == '\n' && i > 0 && str[i-1] == '\r') {
+			return 0, false
+		}
+		if isUpperASCII(c) {
+			run++
+			prevDigit = false
+			continue
+		}
+		if run > 0 {
+			if isLowerASCII(c) {
+				n += run
+			} else {
+				n++
+			}
+			run = 0
+		}
+		digit := isDigitASCII(c)
+		if (digit && !prevDigit) || ti.asciiDelimiters.contains(c) {
+			n++
+		}
+		prevDigit = digit
+	}
+	if run > 0 {
+		n++
+	}
+	return n, true
+}
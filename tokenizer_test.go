@@ -26,6 +26,8 @@ package caps_test
 
 import (
 	"fmt"
+	"math/rand"
+	"reflect"
 	"testing"
 
 	"github.com/chanced/caps"
@@ -89,3 +91,134 @@ func TestTokenizer(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenizerGraphemes(t *testing.T) {
+	tests := []struct {
+		value          string
+		expected       []string
+		allowedSymbols string
+	}{
+		{"Cre\u0300meBru\u0302le\u0301e", []string{"Cre\u0300me", "Bru\u0302le\u0301e"}, ""},
+		{"CAFE\u0301_AU_LAIT", []string{"CAFE\u0301", "AU", "LAIT"}, ""},
+		{"E\u0301TE\u0301summer", []string{"E\u0301", "T", "E\u0301summer"}, ""},
+		{"cafe\u0301 au lait", []string{"cafe\u0301", "au", "lait"}, ""},
+		{"a\U0001F1FA\U0001F1F8b", []string{"a\U0001F1FA\U0001F1F8b"}, "\U0001F1FA"},
+		{"thumbs\U0001F44D\U0001F3FDup", []string{"thumbs\U0001F44D\U0001F3FDup"}, "\U0001F44D"},
+		{"thumbs\U0001F44D\U0001F3FDup", []string{"thumbsup"}, ""},
+	}
+	tokenizer := caps.NewTokenizer(caps.DEFAULT_DELIMITERS, token.DefaultCaser, caps.TokenizerOpts{Graphemes: true})
+	if !tokenizer.Graphemes() {
+		t.Error("expected tokenizer.Graphemes() to return true")
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("#%d___%s", i, test.value), func(t *testing.T) {
+			tokens := tokenizer.Tokenize(test.value, test.allowedSymbols, nil)
+			if !reflect.DeepEqual(tokens, test.expected) {
+				t.Errorf("expected %+q, got %+q", test.expected, tokens)
+			}
+		})
+	}
+}
+
+func TestTokenizerScripts(t *testing.T) {
+	scripts := caps.TokenizerOpts{Scripts: caps.DefaultScripts}
+	keep := caps.TokenizerOpts{KeepCaseless: true}
+	both := caps.TokenizerOpts{Scripts: caps.DefaultScripts, KeepCaseless: true}
+	tests := []struct {
+		value    string
+		expected []string
+		opts     caps.TokenizerOpts
+	}{
+		{"用户ID", []string{"用户", "ID"}, both},
+		{"用户ID", []string{"用户", "ID"}, keep},
+		{"用户ID", []string{"ID"}, scripts},
+		{"用户_id", []string{"用户", "id"}, both},
+		{"ユーザー名Field", []string{"ユーザー", "名", "Field"}, both},
+		{"ユーザー名Field", []string{"ユーザー名", "Field"}, keep},
+		{"USER用户ID", []string{"USER", "用户", "ID"}, both},
+		{"user用户Id", []string{"user", "用户", "Id"}, both},
+		{"userпользователь", []string{"user", "пользователь"}, scripts},
+		{"userпользователь", []string{"userпользователь"}, keep},
+		{"ab用cd", []string{"ab", "cd"}, scripts},
+		{"ภาษาไทยText", []string{"ภาษาไทย", "Text"}, both},
+		{"معرفUser", []string{"معرف", "User"}, both},
+		{"用户2", []string{"用户", "2"}, both},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("#%d___%s", i, test.value), func(t *testing.T) {
+			tokenizer := caps.NewTokenizer(caps.DEFAULT_DELIMITERS, token.DefaultCaser, test.opts)
+			tokens := tokenizer.Tokenize(test.value, "", nil)
+			if !reflect.DeepEqual(tokens, test.expected) {
+				t.Errorf("expected %+q, got %+q", test.expected, tokens)
+			}
+		})
+	}
+}
+
+// asciiTokenizerInputs are ASCII inputs which exercise the branches of the
+// tokenizer.
+var asciiTokenizerInputs = []string{
+	"",
+	"Example Uuid.",
+	"ASnakecaseVariable",
+	"A_SCREAMING_SNAKECASE_VARIABLE",
+	"ServeHTTPRequest2XML",
+	"v1.2.3-beta+build.5",
+	"1.5e10 +12 -3.4E-2",
+	"$1,000.00 and #42",
+	"user's_id^2",
+	"HTTP2Server",
+	"a1B2c3D4",
+	"ABC123def",
+	"line\r\nbreak\tand  spaces",
+	"(x){y}[z]#@&+~!?:;",
+	"..--__",
+	"Ab'Cd",
+}
+
+func TestTokenizerASCII(t *testing.T) {
+	tokenizers := []caps.StdTokenizer{
+		caps.DefaultTokenizer,
+		caps.NewTokenizer(caps.DEFAULT_DELIMITERS, nil, caps.TokenizerOpts{Graphemes: true}),
+		caps.NewTokenizer("_", nil),
+	}
+	rnd := rand.New(rand.NewSource(1))
+	alphabet := "aAbBzZ0189 _-.$#+,'eEvV:^\r\n"
+	inputs := append([]string{}, asciiTokenizerInputs...)
+	for i := 0; i < 5000; i++ {
+		b := make([]byte, rnd.Intn(16))
+		for j := range b {
+			b[j] = alphabet[rnd.Intn(len(alphabet))]
+		}
+		inputs = append(inputs, string(b))
+	}
+	for _, ti := range tokenizers {
+		for _, allowed := range []string{"", ".", "$#", "+-.,e"} {
+			for _, rules := range []caps.NumberRules{nil, canadian} {
+				for _, input := range inputs {
+					expected := caps.TokenizeUnicode(ti, input, allowed, rules)
+					got := ti.Tokenize(input, allowed, rules)
+					if !reflect.DeepEqual(expected, got) {
+						t.Fatalf("Tokenize(%q, %q): expected %q, got %q", input, allowed, expected, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func FuzzTokenizerASCII(f *testing.F) {
+	for _, input := range asciiTokenizerInputs {
+		f.Add(input, "")
+		f.Add(input, ".$")
+	}
+	f.Fuzz(func(t *testing.T, input string, allowed string) {
+		for _, rules := range []caps.NumberRules{nil, canadian} {
+			expected := caps.TokenizeUnicode(caps.DefaultTokenizer, input, allowed, rules)
+			got := caps.DefaultTokenizer.Tokenize(input, allowed, rules)
+			if !reflect.DeepEqual(expected, got) {
+				t.Errorf("Tokenize(%q, %q): expected %q, got %q", input, allowed, expected, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,179 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package translit transliterates text into ASCII.
+//
+// Letters with diacritics are folded (e.g. 'é' becomes "e") and common Latin,
+// Cyrillic and Greek letters are transliterated (e.g. 'ß' becomes "ss", 'Ж'
+// becomes "Zh"). The mapping tables are embedded tab separated files found in
+// the data directory.
+package translit
+
+import (
+	"bufio"
+	"embed"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed data/*.tsv
+var data embed.FS
+
+var (
+	tableOnce sync.Once
+	table     map[rune]string
+)
+
+// loadTable parses the embedded mapping tables.
+func loadTable() {
+	table = make(map[rune]string, 1024)
+	entries, err := data.ReadDir("data")
+	if err != nil {
+		panic("translit: " + err.Error())
+	}
+	for _, entry := range entries {
+		f, err := data.Open("data/" + entry.Name())
+		if err != nil {
+			panic("translit: " + err.Error())
+		}
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if len(line) == 0 || line[0] == '#' {
+				continue
+			}
+			i := strings.IndexByte(line, '\t')
+			if i < 0 {
+				panic("translit: invalid line in " + entry.Name() + ": " + line)
+			}
+			r, _ := utf8.DecodeRuneInString(line[:i])
+			table[r] = line[i+1:]
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			panic("translit: " + err.Error())
+		}
+	}
+}
+
+// Rune returns the ASCII transliteration of r. The second return value
+// reports whether r is ASCII or has a transliteration.
+//
+// Uppercase letters which are transliterated into multiple letters are
+// returned in title case (e.g. 'Ж' returns "Zh").
+func Rune(r rune) (string, bool) {
+	if r < utf8.RuneSelf {
+		return string(r), true
+	}
+	tableOnce.Do(loadTable)
+	s, ok := table[r]
+	return s, ok
+}
+
+// String transliterates s into ASCII where possible.
+//
+// Combining marks are removed. Runes without a transliteration are left
+// unchanged.
+//
+//	translit.String("Crème Brûlée") // Creme Brulee
+//	translit.String("Жуков") // Zhukov
+//	translit.String("ЖУКОВ") // ZHUKOV
+func String(s string) string {
+	return transliterate(s, true)
+}
+
+// ToASCII transliterates s into ASCII.
+//
+// Combining marks and runes without a transliteration are removed.
+//
+//	translit.ToASCII("Łódź") // Lodz
+//	translit.ToASCII("東京 Tokyo") // " Tokyo"
+func ToASCII(s string) string {
+	return transliterate(s, false)
+}
+
+func transliterate(s string, keep bool) string {
+	if isASCII(s) {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range s {
+		if r < utf8.RuneSelf {
+			b.WriteByte(byte(r))
+			continue
+		}
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		t, ok := Rune(r)
+		if !ok {
+			if keep {
+				b.WriteRune(r)
+			}
+			continue
+		}
+		if len(t) > 1 && unicode.IsUpper(r) && shouldUpper(s, i, r) {
+			t = strings.ToUpper(t)
+		}
+		b.WriteString(t)
+	}
+	return b.String()
+}
+
+// shouldUpper reports whether the multi-letter transliteration of the
+// uppercase rune r, found at byte index i of s, should be written entirely in
+// uppercase. That is the case when the next letter is uppercase or, if r ends
+// a word, when the previous letter is uppercase.
+func shouldUpper(s string, i int, r rune) bool {
+	for _, n := range s[i+utf8.RuneLen(r):] {
+		if unicode.Is(unicode.Mn, n) {
+			continue
+		}
+		if unicode.IsLetter(n) {
+			return unicode.IsUpper(n)
+		}
+		break
+	}
+	for i > 0 {
+		p, w := utf8.DecodeLastRuneInString(s[:i])
+		i -= w
+		if unicode.Is(unicode.Mn, p) {
+			continue
+		}
+		return unicode.IsUpper(p)
+	}
+	return false
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package translit_test
+
+import (
+	"testing"
+
+	"github.com/chanced/caps/translit"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"plain ascii", "plain ascii"},
+		{"Crème Brûlée Café", "Creme Brulee Cafe"},
+		{"Crème Brûlée", "Creme Brulee"},
+		{"Łódź", "Lodz"},
+		{"Straße", "Strasse"},
+		{"Ærøskøbing", "Aeroskobing"},
+		{"Œuvre", "Oeuvre"},
+		{"ÆRØ", "AERO"},
+		{"STRAẞE", "STRASSE"},
+		{"Þingvellir", "Thingvellir"},
+		{"Tiếng Việt", "Tieng Viet"},
+		{"Жуков", "Zhukov"},
+		{"ЖУКОВ", "ZHUKOV"},
+		{"Щ", "Shch"},
+		{"ЩИ", "SHCHI"},
+		{"Москва", "Moskva"},
+		{"Ялта", "Yalta"},
+		{"ЯЛТА", "YALTA"},
+		{"объект", "obekt"},
+		{"Αθήνα", "Athina"},
+		{"ΘΕΑ", "THEA"},
+		{"東京 Tokyo", "東京 Tokyo"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := translit.String(test.input); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Łódź", "Lodz"},
+		{"東京 Tokyo", " Tokyo"},
+		{"smile \U0001F600", "smile "},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if got := translit.ToASCII(test.input); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestRune(t *testing.T) {
+	if s, ok := translit.Rune('Ж'); !ok || s != "Zh" {
+		t.Errorf("expected \"Zh\", true; got %q, %v", s, ok)
+	}
+	if s, ok := translit.Rune('a'); !ok || s != "a" {
+		t.Errorf("expected \"a\", true; got %q, %v", s, ok)
+	}
+	if _, ok := translit.Rune('東'); ok {
+		t.Errorf("expected no transliteration for '東'")
+	}
+}
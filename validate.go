@@ -0,0 +1,209 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chanced/caps/token"
+)
+
+// IssueKind is the kind of problem with a Replacement reported by
+// ValidateReplacements.
+type IssueKind uint8
+
+const (
+	IssueKindUnknown IssueKind = iota
+	// The Camel or Screaming form of the Replacement is empty.
+	IssueEmpty
+	// The Replacement has the same keys as an earlier Replacement, which it
+	// overwrites.
+	IssueDuplicate
+	// The Replacement shares one, but not both, of its keys with a later
+	// Replacement and is removed from the table when the later Replacement is
+	// added.
+	IssueShadowed
+	// The Camel and Screaming forms of the Replacement are not the same word
+	// when lowercased (e.g. {"Uid", "UUID"}), resulting in the Replacement
+	// being indexed by two different keys.
+	IssueMismatch
+	// The Replacement shares a key with a Replacement of DefaultReplacements
+	// but differs from it (e.g. {"Id", "Id"}). Only the keys are compared;
+	// the Replacement is reported whether or not it is used alongside
+	// DefaultReplacements.
+	IssueDefaultKey
+	// A key of the Replacement is a prefix of a key of another Replacement
+	// (e.g. {"Ip", "IP"} and {"Ipv4", "IPv4"}). The longest key which matches
+	// is used, so the Replacement does not apply to words beginning with the
+	// longer key (e.g. "ipv4" is never written as "IPV4").
+	IssuePrefix
+)
+
+func (k IssueKind) String() string {
+	switch k {
+	case IssueEmpty:
+		return "IssueEmpty"
+	case IssueDuplicate:
+		return "IssueDuplicate"
+	case IssueShadowed:
+		return "IssueShadowed"
+	case IssueMismatch:
+		return "IssueMismatch"
+	case IssueDefaultKey:
+		return "IssueDefaultKey"
+	case IssuePrefix:
+		return "IssuePrefix"
+	}
+	return "IssueKindUnknown"
+}
+
+// Issue is a problem with a Replacement reported by ValidateReplacements.
+type Issue struct {
+	Kind IssueKind
+	// Index is the position of Replacement in the validated slice.
+	Index int
+	// Replacement is the Replacement with the issue.
+	Replacement Replacement
+	// Other is the position of the conflicting Replacement in the validated
+	// slice for IssueDuplicate, IssueShadowed, and IssuePrefix. It is -1
+	// otherwise.
+	Other int
+	// Conflict is the conflicting Replacement for IssueDuplicate,
+	// IssueShadowed, IssuePrefix, and IssueDefaultKey, where it is the
+	// Replacement of DefaultReplacements which shares a key.
+	Conflict Replacement
+}
+
+func (i Issue) String() string {
+	r := i.Replacement
+	switch i.Kind {
+	case IssueEmpty:
+		return fmt.Sprintf("replacement %d {%q, %q} is empty", i.Index, r.Camel, r.Screaming)
+	case IssueDuplicate:
+		return fmt.Sprintf("replacement %d {%q, %q} duplicates replacement %d {%q, %q}", i.Index, r.Camel, r.Screaming, i.Other, i.Conflict.Camel, i.Conflict.Screaming)
+	case IssueShadowed:
+		return fmt.Sprintf("replacement %d {%q, %q} is shadowed by replacement %d {%q, %q}", i.Index, r.Camel, r.Screaming, i.Other, i.Conflict.Camel, i.Conflict.Screaming)
+	case IssueMismatch:
+		return fmt.Sprintf("replacement %d {%q, %q} has mismatched camel and screaming forms", i.Index, r.Camel, r.Screaming)
+	case IssueDefaultKey:
+		return fmt.Sprintf("replacement %d {%q, %q} shares a key with the default replacement {%q, %q}", i.Index, r.Camel, r.Screaming, i.Conflict.Camel, i.Conflict.Screaming)
+	case IssuePrefix:
+		return fmt.Sprintf("replacement %d {%q, %q} is a prefix of replacement %d {%q, %q}", i.Index, r.Camel, r.Screaming, i.Other, i.Conflict.Camel, i.Conflict.Screaming)
+	}
+	return fmt.Sprintf("replacement %d {%q, %q}: %s", i.Index, r.Camel, r.Screaming, i.Kind)
+}
+
+// ValidateReplacements reports problems with replacements, in the order of
+// the Replacements they concern. Replacements are compared in the same way
+// they are indexed by NewConverter, using the lowercase form of both Camel
+// and Screaming as keys.
+//
+// The result is empty if replacements can be passed to NewConverter without
+// any Replacement being overwritten, removed, partially matched by a longer
+// key, or sharing a key with DefaultReplacements. Prefixes are reported
+// between the Replacements which remain indexed, so DefaultReplacements
+// (e.g. {"Http", "HTTP"} and {"Https", "HTTPS"}) only result in IssuePrefix.
+//
+// A key which begins within another key (e.g. "bc" within "abd") may not be
+// matched when a word is split into single letters (e.g. "A_B_C"), as
+// matching does not backtrack. This is not reported since it applies to any
+// two keys which share a letter, including those of DefaultReplacements.
+func ValidateReplacements(replacements []Replacement) []Issue {
+	lower := func(s string) string { return token.ToLower(token.DefaultCaser, s) }
+
+	defaults := make(map[string]Replacement, 2*len(DefaultReplacements))
+	for _, d := range DefaultReplacements {
+		defaults[lower(d.Camel)] = d
+		defaults[lower(d.Screaming)] = d
+	}
+
+	var issues []Issue
+	// owners contains the position of the Replacement currently indexed by
+	// each key
+	owners := make(map[string]int, 2*len(replacements))
+	keys := func(r Replacement) [2]string {
+		return [2]string{lower(r.Camel), lower(r.Screaming)}
+	}
+	for i, r := range replacements {
+		if len(r.Camel) == 0 || len(r.Screaming) == 0 {
+			issues = append(issues, Issue{Kind: IssueEmpty, Index: i, Replacement: r, Other: -1})
+			continue
+		}
+		k := keys(r)
+		if k[0] != k[1] {
+			issues = append(issues, Issue{Kind: IssueMismatch, Index: i, Replacement: r, Other: -1})
+		}
+		for _, key := range k {
+			j, ok := owners[key]
+			if !ok {
+				continue
+			}
+			prev := replacements[j]
+			pk := keys(prev)
+			if pk == k || (pk[0] == k[1] && pk[1] == k[0]) {
+				issues = append(issues, Issue{Kind: IssueDuplicate, Index: i, Replacement: r, Other: j, Conflict: prev})
+			} else {
+				issues = append(issues, Issue{Kind: IssueShadowed, Index: j, Replacement: prev, Other: i, Conflict: r})
+			}
+			delete(owners, pk[0])
+			delete(owners, pk[1])
+		}
+		owners[k[0]] = i
+		owners[k[1]] = i
+
+		for _, key := range k {
+			if d, ok := defaults[key]; ok && d != r {
+				issues = append(issues, Issue{Kind: IssueDefaultKey, Index: i, Replacement: r, Other: -1, Conflict: d})
+				break
+			}
+		}
+	}
+
+	// sorted, the keys which begin with a key immediately follow it
+	indexed := make([]string, 0, len(owners))
+	for key := range owners {
+		indexed = append(indexed, key)
+	}
+	sort.Strings(indexed)
+	reported := make(map[[2]int]bool)
+	for n, key := range indexed {
+		i := owners[key]
+		for _, longer := range indexed[n+1:] {
+			if !strings.HasPrefix(longer, key) {
+				break
+			}
+			j := owners[longer]
+			if i == j || reported[[2]int{i, j}] {
+				continue
+			}
+			reported[[2]int{i, j}] = true
+			issues = append(issues, Issue{Kind: IssuePrefix, Index: i, Replacement: replacements[i], Other: j, Conflict: replacements[j]})
+		}
+	}
+	sort.SliceStable(issues, func(a, b int) bool { return issues[a].Index < issues[b].Index })
+	return issues
+}
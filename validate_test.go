@@ -0,0 +1,108 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2022 Chance Dinkins <chanceusc@gmail.com>
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, Subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or Substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package caps_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chanced/caps"
+)
+
+func TestValidateReplacements(t *testing.T) {
+	for _, issue := range caps.ValidateReplacements(caps.DefaultReplacements) {
+		if issue.Kind != caps.IssuePrefix {
+			t.Errorf("expected DefaultReplacements to only have prefixes, got %v", issue)
+		}
+	}
+	replacements := []caps.Replacement{
+		{"Grpc", "GRPC"}, // 0
+		{"", "K8S"},      // 1
+		{"Uuid", "UUID"}, // 2
+		{"Uid", "UUID"},  // 3
+		{"GRPC", "Grpc"}, // 4
+		{"Id", "Id"},     // 5
+		{"Über", "ÜBER"}, // 6
+		{"Http", "HTTP"}, // 7
+		{"Smsc", "SMSC"}, // 8
+		{"Smsc", "SMSC"}, // 9
+	}
+	expected := []struct {
+		kind  caps.IssueKind
+		index int
+		other int
+	}{
+		{caps.IssueEmpty, 1, -1},
+		{caps.IssueShadowed, 2, 3},
+		{caps.IssueMismatch, 3, -1},
+		{caps.IssueDefaultKey, 3, -1},
+		{caps.IssueDuplicate, 4, 0},
+		{caps.IssueDefaultKey, 5, -1},
+		{caps.IssueDuplicate, 9, 8},
+	}
+	issues := caps.ValidateReplacements(replacements)
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %d: %v", len(expected), len(issues), issues)
+	}
+	for i, issue := range issues {
+		e := expected[i]
+		if issue.Kind != e.kind || issue.Index != e.index || issue.Other != e.other {
+			t.Errorf("expected issue %d to be %s of %d (other %d), got %s of %d (other %d)", i, e.kind, e.index, e.other, issue.Kind, issue.Index, issue.Other)
+		}
+		if !reflect.DeepEqual(issue.Replacement, replacements[e.index]) {
+			t.Errorf("expected issue %d to concern %v, got %v", i, replacements[e.index], issue.Replacement)
+		}
+	}
+	if got, expected := issues[1].String(), `replacement 2 {"Uuid", "UUID"} is shadowed by replacement 3 {"Uid", "UUID"}`; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestValidateReplacementsPrefix(t *testing.T) {
+	replacements := []caps.Replacement{{"Ipv4", "IPv4"}, {"Uid", "UID"}, {"Ip", "IP"}, {"Uuid", "UUID"}, {"Uu", "UU"}}
+	issues := caps.ValidateReplacements(replacements)
+	expected := []caps.Issue{
+		{Kind: caps.IssuePrefix, Index: 2, Replacement: replacements[2], Other: 0, Conflict: replacements[0]},
+		{Kind: caps.IssuePrefix, Index: 4, Replacement: replacements[4], Other: 3, Conflict: replacements[3]},
+	}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected %v, got %v", expected, issues)
+	}
+	if got, expected := issues[0].String(), `replacement 2 {"Ip", "IP"} is a prefix of replacement 0 {"Ipv4", "IPv4"}`; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	replacements = replacements[:3]
+	c := caps.New(caps.Config{Replacements: replacements})
+	for input, expected := range map[string]string{
+		"ipv4_addr": "IPv4Addr",
+		"ip_addr":   "IPAddr",
+		"I_P_V_4":   "IPv4",
+		"I_P_V_6":   "IPV6",
+	} {
+		if got := c.ToCamel(input); got != expected {
+			t.Errorf("ToCamel(%q): expected %q, got %q", input, expected, got)
+		}
+	}
+}